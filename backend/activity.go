@@ -0,0 +1,208 @@
+package main
+
+import (
+    "context"
+    "log"
+    "strings"
+
+    "github.com/uptrace/bun"
+)
+
+// scriptActivity counts distinct scripts that received or spent a VTXO in a
+// period. New scripts received their first VTXO in the period; returning
+// scripts had been seen before it.
+type scriptActivity struct {
+    Active    int
+    New       int
+    Returning int
+}
+
+// scriptActivitySince returns the activity from each of starts (unix
+// seconds) until now. Like scriptActivityBetween it reads only the widest
+// window, and takes new scripts from known_scripts.
+func scriptActivitySince(ctx context.Context, asp string, starts ...int64) ([]scriptActivity, error) {
+    since := starts[0]
+    for _, start := range starts[1:] {
+        since = min(since, start)
+    }
+
+    received := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("script, created_at AS ts").
+        Where("created_at >= ?", since)
+    spent := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("script, spent_at AS ts").
+        Where("is_spent = ? AND spent_at >= ?", true, since)
+    lastActive := DB.NewSelect().
+        TableExpr("(?) AS e", received.UnionAll(spent)).
+        ColumnExpr("MAX(ts) AS ts").
+        Group("script")
+
+    first := whereASP(DB.NewSelect().Model((*KnownScript)(nil)), asp).
+        ColumnExpr("MIN(first_seen) AS ts").
+        Where("first_seen >= ?", since).
+        Group("known_script.script")
+    if asp == "" {
+        // Across ASPs a script is new only if no ASP saw it earlier
+        earlier := DB.NewSelect().
+            TableExpr("known_scripts AS k").
+            ColumnExpr("1").
+            Where("k.script = known_script.script AND k.first_seen < ?", since)
+        first = first.Where("NOT EXISTS (?)", earlier)
+    }
+
+    active := DB.NewSelect().TableExpr("(?) AS s", lastActive)
+    fresh := DB.NewSelect().TableExpr("(?) AS s", first)
+    counts := make([]int64, 2*len(starts))
+    activeDest := make([]interface{}, len(starts))
+    freshDest := make([]interface{}, len(starts))
+    for i, start := range starts {
+        active = active.ColumnExpr("COALESCE(SUM(ts >= ?), 0)", start)
+        fresh = fresh.ColumnExpr("COALESCE(SUM(ts >= ?), 0)", start)
+        activeDest[i], freshDest[i] = &counts[2*i], &counts[2*i+1]
+    }
+    if err := active.Scan(ctx, activeDest...); err != nil {
+        return nil, err
+    }
+    if err := fresh.Scan(ctx, freshDest...); err != nil {
+        return nil, err
+    }
+
+    // A new script received its first VTXO in the window, so it is active
+    out := make([]scriptActivity, len(starts))
+    for i := range out {
+        out[i] = scriptActivity{Active: int(counts[2*i]), New: int(counts[2*i+1])}
+        out[i].Returning = out[i].Active - out[i].New
+    }
+    return out, nil
+}
+
+// scriptActivityBetween returns the activity in [from, to), unix seconds;
+// to 0 leaves the window open. Unlike scriptActivitySince it handles windows
+// that ended in the past.
+func scriptActivityBetween(ctx context.Context, asp string, from, to int64) (scriptActivity, error) {
+    window := func(q *bun.SelectQuery, column string) *bun.SelectQuery {
+        q = q.Where(column+" >= ?", from)
+        if to > 0 {
+            q = q.Where(column+" < ?", to)
+        }
+        return q
+    }
+    received := window(whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).ColumnExpr("script"), "created_at")
+    spent := window(whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).ColumnExpr("script").Where("is_spent = ?", true), "spent_at")
+
+    // UNION, not UNION ALL, so each active script appears once
+    var active, fresh int
+    err := DB.NewSelect().
+        TableExpr("(?) AS e", received.Union(spent)).
+        ColumnExpr("COUNT(*)").
+        Scan(ctx, &active)
+    if err != nil {
+        return scriptActivity{}, err
+    }
+
+    // A new script received its first VTXO in the window, so it is active
+    first := window(whereASP(DB.NewSelect().Model((*KnownScript)(nil)), asp), "first_seen").
+        ColumnExpr("COUNT(DISTINCT known_script.script)")
+    if asp == "" {
+        // Across ASPs a script is new only if no ASP saw it earlier
+        earlier := DB.NewSelect().
+            TableExpr("known_scripts AS k").
+            ColumnExpr("1").
+            Where("k.script = known_script.script AND k.first_seen < ?", from)
+        first = first.Where("NOT EXISTS (?)", earlier)
+    }
+    if err := first.Scan(ctx, &fresh); err != nil {
+        return scriptActivity{}, err
+    }
+    return scriptActivity{Active: active, New: fresh, Returning: active - fresh}, nil
+}
+
+// storeKnownScripts records the scripts of VTXOs, keeping the earliest time
+// each was seen.
+func storeKnownScripts(ctx context.Context, vtxos []*VTXO) {
+    scripts := make([]KnownScript, 0, len(vtxos))
+    for _, v := range vtxos {
+        if v.Script != "" {
+            scripts = append(scripts, KnownScript{Script: v.Script, Asp: v.Asp, FirstSeen: v.CreatedAt})
+        }
+    }
+    if len(scripts) == 0 {
+        return
+    }
+    _, err := DB.NewInsert().Model(&scripts).
+        On("DUPLICATE KEY UPDATE").
+        Set("first_seen = LEAST(first_seen, VALUES(first_seen))").
+        Exec(ctx)
+    if err != nil {
+        log.Printf("Error storing known scripts: %v", err)
+    }
+}
+
+// setScriptActivity fills the day, week and month script activity of a
+// stats snapshot for its ASP, as of now (unix seconds).
+func setScriptActivity(ctx context.Context, stats *NetworkStats, now int64) {
+    a, err := scriptActivitySince(ctx, stats.Asp, now-86400, now-7*86400, now-30*86400)
+    if err != nil {
+        log.Printf("Error counting script activity for %s: %v", stats.Asp, err)
+        return
+    }
+    stats.ActiveScriptsDay, stats.NewScriptsDay, stats.ReturningScriptsDay = a[0].Active, a[0].New, a[0].Returning
+    stats.ActiveScriptsWeek, stats.NewScriptsWeek, stats.ReturningScriptsWeek = a[1].Active, a[1].New, a[1].Returning
+    stats.ActiveScriptsMonth, stats.NewScriptsMonth, stats.ReturningScriptsMonth = a[2].Active, a[2].New, a[2].Returning
+}
+
+// trendScriptActivity buckets script activity like queryTrends. dateFormat
+// is the bucket expression over created_at; results are keyed by bucket and,
+// with byASP set, ASP.
+func trendScriptActivity(ctx context.Context, dateFormat string, since int64, asp string, byASP bool) (map[string]scriptActivity, error) {
+    received := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("script, asp, created_at AS ts").
+        Where("created_at >= ?", since)
+    spent := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("script, asp, spent_at AS ts").
+        Where("is_spent = ? AND spent_at >= ?", true, since)
+
+    first := whereASP(DB.NewSelect().Model((*KnownScript)(nil)), asp).
+        ColumnExpr("script").
+        ColumnExpr("MIN(first_seen) AS first_seen")
+    join := "f.script = e.script"
+    if byASP {
+        first = first.ColumnExpr("asp").Group("script", "asp")
+        join += " AND f.asp = e.asp"
+    } else {
+        first = first.Group("script")
+    }
+
+    bucket := strings.ReplaceAll(dateFormat, "created_at", "e.ts")
+    firstBucket := strings.ReplaceAll(dateFormat, "created_at", "f.first_seen")
+
+    var rows []struct {
+        DisplayDate string `bun:"display_date"`
+        Asp         string `bun:"asp"`
+        Active      int    `bun:"active_scripts"`
+        New         int    `bun:"new_scripts"`
+    }
+    q := DB.NewSelect().
+        TableExpr("(?) AS e", received.UnionAll(spent)).
+        Join("JOIN (?) AS f ON "+join, first).
+        ColumnExpr(bucket+" AS display_date").
+        ColumnExpr("COUNT(DISTINCT e.script) AS active_scripts").
+        ColumnExpr("COUNT(DISTINCT CASE WHEN "+firstBucket+" = "+bucket+" THEN e.script END) AS new_scripts").
+        Group("display_date")
+    if byASP {
+        q = q.ColumnExpr("e.asp AS asp").GroupExpr("e.asp")
+    }
+    if err := q.Scan(ctx, &rows); err != nil {
+        return nil, err
+    }
+
+    out := make(map[string]scriptActivity, len(rows))
+    for _, row := range rows {
+        out[row.DisplayDate+"|"+row.Asp] = scriptActivity{
+            Active:    row.Active,
+            New:       row.New,
+            Returning: row.Active - row.New,
+        }
+    }
+    return out, nil
+}
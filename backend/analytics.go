@@ -0,0 +1,302 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "net/http"
+    "strings"
+    "time"
+
+    "github.com/uptrace/bun"
+)
+
+// histogramBucket is a bucket with an exclusive upper bound; 0 marks the
+// open-ended last bucket.
+type histogramBucket struct {
+    Label string `json:"label"`
+    Max   int64  `json:"max"`
+}
+
+// bucketIndexExpr maps valueExpr to an index into buckets.
+func bucketIndexExpr(valueExpr string, buckets []histogramBucket) string {
+    var b strings.Builder
+    b.WriteString("CASE")
+    for i, bucket := range buckets[:len(buckets)-1] {
+        fmt.Fprintf(&b, " WHEN %s < %d THEN %d", valueExpr, bucket.Max, i)
+    }
+    fmt.Fprintf(&b, " ELSE %d END", len(buckets)-1)
+    return b.String()
+}
+
+// nearestRankPercentiles returns the nearest-rank percentiles ps, given in
+// per mille (500 is the median), of valueExpr over the rows of q for each
+// value of partExpr. With an empty partExpr all rows form one partition,
+// keyed "". Ranks use integer arithmetic so SQL and Go agree on them.
+func nearestRankPercentiles(ctx context.Context, q *bun.SelectQuery, partExpr, valueExpr string, ps []int) (map[string][]int64, error) {
+    part, partition := "''", ""
+    if partExpr != "" {
+        part, partition = partExpr, "PARTITION BY "+partExpr
+    }
+    ranked := q.
+        ColumnExpr(part+" AS part").
+        ColumnExpr(valueExpr+" AS value").
+        ColumnExpr("ROW_NUMBER() OVER ("+partition+" ORDER BY "+valueExpr+") AS rn").
+        ColumnExpr("COUNT(*) OVER ("+partition+") AS cnt")
+
+    conds := make([]string, len(ps))
+    for i, p := range ps {
+        conds[i] = fmt.Sprintf("rn = FLOOR((cnt * %d + 999) / 1000)", p)
+    }
+    var rows []struct {
+        Part  string `bun:"part"`
+        Value int64  `bun:"value"`
+        Rn    int64  `bun:"rn"`
+        Cnt   int64  `bun:"cnt"`
+    }
+    err := DB.NewSelect().
+        TableExpr("(?) AS ranked", ranked).
+        ColumnExpr("part, value, rn, cnt").
+        Where(strings.Join(conds, " OR ")).
+        Scan(ctx, &rows)
+    if err != nil {
+        return nil, err
+    }
+
+    out := make(map[string][]int64)
+    for _, row := range rows {
+        values := out[row.Part]
+        if values == nil {
+            values = make([]int64, len(ps))
+            out[row.Part] = values
+        }
+        for i, p := range ps {
+            if row.Rn == (row.Cnt*int64(p)+999)/1000 {
+                values[i] = row.Value
+            }
+        }
+    }
+    return out, nil
+}
+
+// lifetimeClassExpr classifies how a VTXO ended, with the refreshed and
+// swept definitions of refresh.go; its life ends at refreshTimeExpr.
+const (
+    lifetimeClassExpr = "CASE WHEN " + isRefreshedExpr + " THEN 'refreshed' WHEN " + isSweptExpr + " THEN 'swept' " +
+        "WHEN tx_type = 'offboard' THEN 'offboarded' ELSE 'spent' END"
+    lifetimeExpr = "(" + refreshTimeExpr + ") - created_at"
+)
+
+var lifetimeClasses = []string{"spent", "refreshed", "offboarded", "swept"}
+
+// lifetimeBuckets bound VTXO lifetimes in seconds.
+var lifetimeBuckets = []histogramBucket{
+    {"<1m", 60},
+    {"1m-10m", 600},
+    {"10m-1h", 3600},
+    {"1h-6h", 6 * 3600},
+    {"6h-1d", 24 * 3600},
+    {"1d-7d", 7 * 24 * 3600},
+    {"7d-30d", 30 * 24 * 3600},
+    {">30d", 0},
+}
+
+// GetLifetimes reports how long VTXOs lived before being spent, refreshed,
+// offboarded or swept: a histogram and p50/p90/p99 per class, for VTXOs
+// whose life ended inside the timeframe.
+func GetLifetimes(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    now := time.Now().Unix()
+
+    ended := func(q *bun.SelectQuery) *bun.SelectQuery {
+        q = filterASP(q, r).
+            Where("(is_spent = ? OR ("+isSweptExpr+" AND expires_at <= ?))", true, now).
+            Where(refreshTimeExpr+" >= created_at AND created_at > 0")
+        if window := timeframeSeconds(timeframe); window > 0 {
+            q = q.Where(refreshTimeExpr+" >= ?", now-window)
+        }
+        return q
+    }
+
+    type LifetimeClass struct {
+        Class       string  `bun:"class" json:"class"`
+        Count       int     `bun:"count" json:"count"`
+        Volume      float64 `bun:"volume" json:"volume"`
+        MeanSeconds float64 `bun:"mean_seconds" json:"meanSeconds"`
+        P50Seconds  int64   `bun:"-" json:"p50Seconds"`
+        P90Seconds  int64   `bun:"-" json:"p90Seconds"`
+        P99Seconds  int64   `bun:"-" json:"p99Seconds"`
+        Histogram   []int   `bun:"-" json:"histogram"`
+    }
+
+    var summary []LifetimeClass
+    err = ended(DB.NewSelect().Model((*VTXO)(nil))).
+        ColumnExpr(lifetimeClassExpr+" AS class").
+        ColumnExpr("COUNT(*) AS count").
+        ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0 AS volume").
+        ColumnExpr("COALESCE(AVG("+lifetimeExpr+"), 0) AS mean_seconds").
+        Group("class").
+        Scan(ctx, &summary)
+    if err != nil {
+        return err
+    }
+
+    var hist []struct {
+        Class  string `bun:"class"`
+        Bucket int    `bun:"bucket"`
+        Count  int    `bun:"count"`
+    }
+    err = ended(DB.NewSelect().Model((*VTXO)(nil))).
+        ColumnExpr(lifetimeClassExpr+" AS class").
+        ColumnExpr(bucketIndexExpr(lifetimeExpr, lifetimeBuckets)+" AS bucket").
+        ColumnExpr("COUNT(*) AS count").
+        Group("class", "bucket").
+        Scan(ctx, &hist)
+    if err != nil {
+        return err
+    }
+
+    percentiles, err := nearestRankPercentiles(ctx, ended(DB.NewSelect().Model((*VTXO)(nil))),
+        lifetimeClassExpr, lifetimeExpr, []int{500, 900, 990})
+    if err != nil {
+        return err
+    }
+
+    byClass := make(map[string]*LifetimeClass)
+    classes := make([]LifetimeClass, len(lifetimeClasses))
+    for i, c := range lifetimeClasses {
+        classes[i] = LifetimeClass{Class: c, Histogram: make([]int, len(lifetimeBuckets))}
+        byClass[c] = &classes[i]
+    }
+    for _, s := range summary {
+        if c := byClass[s.Class]; c != nil {
+            c.Count, c.Volume, c.MeanSeconds = s.Count, s.Volume, s.MeanSeconds
+        }
+    }
+    for _, h := range hist {
+        if c := byClass[h.Class]; c != nil && h.Bucket < len(c.Histogram) {
+            c.Histogram[h.Bucket] = h.Count
+        }
+    }
+    for class, p := range percentiles {
+        if c := byClass[class]; c != nil {
+            c.P50Seconds, c.P90Seconds, c.P99Seconds = p[0], p[1], p[2]
+        }
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "buckets":   lifetimeBuckets,
+        "classes":   classes,
+        "timestamp": now * 1000,
+    })
+}
+
+// amountBuckets bound VTXO amounts in sats on a log scale. Dust is below the
+// P2TR dust limit.
+var amountBuckets = []histogramBucket{
+    {"dust", 330},
+    {"<10k", 10_000},
+    {"<100k", 100_000},
+    {"<1M", 1_000_000},
+    {"<10M", 10_000_000},
+    {"<1BTC", 100_000_000},
+    {">=1BTC", 0},
+}
+
+// GetAmountDistribution buckets unspent VTXOs and the timeframe's virtual
+// transfers by size, and summarizes virtual transfer amounts, so a few large
+// transfers cannot hide what typical payments look like.
+func GetAmountDistribution(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    now := time.Now().Unix()
+    var periodStart int64
+    if window := timeframeSeconds(timeframe); window > 0 {
+        periodStart = now - window
+    }
+
+    type AmountBucket struct {
+        histogramBucket
+        Bucket       int     `bun:"bucket" json:"-"`
+        UnspentCount int     `bun:"unspent_count" json:"unspentCount"`
+        UnspentValue float64 `bun:"unspent_value" json:"unspentValue"`
+        VirtualCount int     `bun:"virtual_count" json:"virtualCount"`
+        VirtualValue float64 `bun:"virtual_value" json:"virtualValue"`
+    }
+
+    isVirtual := "tx_type = 'virtual' AND created_at >= ?"
+    var rows []AmountBucket
+    err = filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).
+        ColumnExpr(bucketIndexExpr("amount", amountBuckets)+" AS bucket").
+        ColumnExpr("COUNT(CASE WHEN is_spent = 0 THEN 1 END) AS unspent_count").
+        ColumnExpr("COALESCE(SUM(CASE WHEN is_spent = 0 THEN amount END), 0) / 100000000.0 AS unspent_value").
+        ColumnExpr("COUNT(CASE WHEN "+isVirtual+" THEN 1 END) AS virtual_count", periodStart).
+        ColumnExpr("COALESCE(SUM(CASE WHEN "+isVirtual+" THEN amount END), 0) / 100000000.0 AS virtual_value", periodStart).
+        WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+            return q.Where("is_spent = 0").WhereOr(isVirtual, periodStart)
+        }).
+        Group("bucket").
+        Scan(ctx, &rows)
+    if err != nil {
+        return err
+    }
+
+    buckets := make([]AmountBucket, len(amountBuckets))
+    for i, b := range amountBuckets {
+        buckets[i].histogramBucket = b
+    }
+    for _, row := range rows {
+        if row.Bucket < len(buckets) {
+            row.histogramBucket = buckets[row.Bucket].histogramBucket
+            buckets[row.Bucket] = row
+        }
+    }
+
+    virtual := func() *bun.SelectQuery {
+        return filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).Where(isVirtual, periodStart)
+    }
+    var summary struct {
+        Count int     `bun:"count"`
+        Mean  float64 `bun:"mean"`
+    }
+    err = virtual().
+        ColumnExpr("COUNT(*) AS count").
+        ColumnExpr("COALESCE(AVG(amount), 0) AS mean").
+        Scan(ctx, &summary)
+    if err != nil {
+        return err
+    }
+    percentiles, err := nearestRankPercentiles(ctx, virtual(), "", "amount", []int{100, 250, 500, 750, 900, 990})
+    if err != nil {
+        return err
+    }
+    p := percentiles[""]
+    if p == nil {
+        p = make([]int64, 6)
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "buckets":   buckets,
+        "virtualAmounts": map[string]interface{}{
+            "count":  summary.Count,
+            "mean":   summary.Mean,
+            "p10":    p[0],
+            "p25":    p[1],
+            "median": p[2],
+            "p75":    p[3],
+            "p90":    p[4],
+            "p99":    p[5],
+        },
+        "timestamp": now * 1000,
+    })
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "log"
+    "math"
+    "net/http"
+    "strconv"
+    "time"
+
+    "github.com/uptrace/bun"
+)
+
+// Anomaly detection settings, set from flags in main.
+var (
+    alertZScore     = 4.0
+    alertWebhookURL = ""
+    alertQuietAfter = 30 * time.Minute
+)
+
+const (
+    anomalyInterval  = 5 * time.Minute
+    baselineHours    = 7 * 24
+    minBaselineHours = 24
+    alertCooldown    = time.Hour
+)
+
+// anomalyMetrics are compared, per ASP, over the hour ending now against
+// the hours before it. Offboarding is dated by when VTXOs left, so a mass
+// exit shows up at once. A stream that goes quiet is caught sooner by
+// detectQuietStreams.
+var anomalyMetrics = []string{"onboarding_volume", "offboarding_volume", "virtual_volume", "virtual_tx_count", "liquidity_change"}
+
+// hourlyMetrics returns each metric for the hours ending now, index 0 being
+// the current hour, limited to the hours the ASP has history for.
+func hourlyMetrics(ctx context.Context, asp string, now int64) (map[string][]float64, error) {
+    since := now - (baselineHours+1)*3600
+    var created []struct {
+        Ago          int     `bun:"ago"`
+        Onboard      float64 `bun:"onboard"`
+        Virtual      float64 `bun:"virtual_volume"`
+        VirtualCount float64 `bun:"virtual_count"`
+    }
+    err := DB.NewSelect().Model((*VTXO)(nil)).
+        ColumnExpr("FLOOR((? - created_at) / 3600) AS ago", now).
+        ColumnExpr("SUM(CASE WHEN tx_type = 'onboard' THEN amount ELSE 0 END) / 100000000.0 AS onboard").
+        ColumnExpr("SUM(CASE WHEN tx_type = 'virtual' THEN amount ELSE 0 END) / 100000000.0 AS virtual_volume").
+        ColumnExpr("COUNT(CASE WHEN tx_type = 'virtual' THEN 1 END) AS virtual_count").
+        Where("asp = ? AND created_at > ? AND created_at <= ?", asp, since, now).
+        Group("ago").
+        Scan(ctx, &created)
+    if err != nil {
+        return nil, err
+    }
+
+    var exited []struct {
+        Ago      int     `bun:"ago"`
+        Offboard float64 `bun:"offboard"`
+    }
+    err = DB.NewSelect().Model((*VTXO)(nil)).
+        ColumnExpr("FLOOR((? - spent_at) / 3600) AS ago", now).
+        ColumnExpr("SUM(amount) / 100000000.0 AS offboard").
+        Where("asp = ? AND tx_type = 'offboard' AND is_spent AND NOT is_swept", asp).
+        Where("spent_at > ? AND spent_at <= ?", since, now).
+        Group("ago").
+        Scan(ctx, &exited)
+    if err != nil {
+        return nil, err
+    }
+
+    var liquidity []struct {
+        Ago       int     `bun:"ago"`
+        Liquidity float64 `bun:"liquidity"`
+    }
+    err = DB.NewSelect().Model((*NetworkStats)(nil)).
+        ColumnExpr("FLOOR((? - timestamp) / 3600000) AS ago", now*1000).
+        ColumnExpr("AVG(network_liquidity) / 100000000.0 AS liquidity").
+        Where("asp = ? AND timestamp > ? AND timestamp <= ?", asp, since*1000, now*1000).
+        Group("ago").
+        Scan(ctx, &liquidity)
+    if err != nil {
+        return nil, err
+    }
+
+    // Hours before the ASP's first activity are unknown, not quiet. Any of
+    // the three counts, so a dormant ASP's mass offboard still has a history
+    history := -1
+    for _, row := range created {
+        history = max(history, row.Ago)
+    }
+    for _, row := range exited {
+        history = max(history, row.Ago)
+    }
+    for _, row := range liquidity {
+        history = max(history, row.Ago)
+    }
+    if history < 0 {
+        return nil, nil
+    }
+    series := make(map[string][]float64, len(anomalyMetrics))
+    for _, m := range anomalyMetrics[:4] {
+        series[m] = make([]float64, history+1)
+    }
+    for _, row := range created {
+        series["onboarding_volume"][row.Ago] = row.Onboard
+        series["virtual_volume"][row.Ago] = row.Virtual
+        series["virtual_tx_count"][row.Ago] = row.VirtualCount
+    }
+    for _, row := range exited {
+        series["offboarding_volume"][row.Ago] = row.Offboard
+    }
+
+    // Liquidity is a level; compare hour-over-hour changes where both hours
+    // have snapshots
+    level := make(map[int]float64, len(liquidity))
+    for _, row := range liquidity {
+        level[row.Ago] = row.Liquidity
+    }
+    _, cur := level[0]
+    _, prev := level[1]
+    if cur && prev {
+        changes := make([]float64, 0, len(level))
+        for ago := 0; ago < baselineHours; ago++ {
+            if _, ok := level[ago]; !ok {
+                continue
+            }
+            if _, ok := level[ago+1]; ok {
+                changes = append(changes, level[ago]-level[ago+1])
+            }
+        }
+        series["liquidity_change"] = changes
+    }
+    return series, nil
+}
+
+// zScore compares values[0] with the mean and standard deviation of the
+// rest. ok is false without enough history or variation to judge.
+func zScore(values []float64) (z, mean, stdDev float64, ok bool) {
+    if len(values) <= minBaselineHours {
+        return 0, 0, 0, false
+    }
+    baseline := values[1:]
+    for _, v := range baseline {
+        mean += v
+    }
+    mean /= float64(len(baseline))
+    for _, v := range baseline {
+        stdDev += (v - mean) * (v - mean)
+    }
+    stdDev = math.Sqrt(stdDev / float64(len(baseline)))
+    if stdDev == 0 {
+        return 0, mean, 0, false
+    }
+    return (values[0] - mean) / stdDev, mean, stdDev, true
+}
+
+// detectAnomalies raises an alert for every metric of every ASP whose
+// current hour is alertZScore standard deviations from its baseline, at
+// most once per alertCooldown per metric and direction.
+func detectAnomalies(ctx context.Context) {
+    now := time.Now().Unix()
+    for _, asp := range knownASPs(ctx) {
+        series, err := hourlyMetrics(ctx, asp, now)
+        if err != nil {
+            log.Printf("Error computing baselines for %s: %v", asp, err)
+            continue
+        }
+        for _, metric := range anomalyMetrics {
+            values := series[metric]
+            z, mean, stdDev, ok := zScore(values)
+            if !ok || math.Abs(z) < alertZScore {
+                continue
+            }
+            direction := "spike"
+            if z < 0 {
+                direction = "drop"
+            }
+
+            raiseAlert(ctx, &Alert{
+                CreatedAt: now,
+                Asp:       asp,
+                Metric:    metric,
+                Direction: direction,
+                Value:     values[0],
+                Mean:      mean,
+                StdDev:    stdDev,
+                ZScore:    z,
+                Message: fmt.Sprintf("%s %s on %s: %.8g in the last hour against %.8g ± %.8g (z=%.1f)",
+                    metric, direction, asp, values[0], mean, stdDev, z),
+            })
+        }
+    }
+}
+
+// detectQuietStreams raises a stream_quiet alert for every ingested ASP
+// whose stream has delivered nothing for alertQuietAfter. Value is the
+// silence in minutes.
+func detectQuietStreams(ctx context.Context) {
+    if alertQuietAfter <= 0 {
+        return
+    }
+    now := time.Now()
+    lastEventAt.Range(func(key, value interface{}) bool {
+        asp, quiet := key.(string), now.Sub(value.(time.Time))
+        if quiet >= alertQuietAfter {
+            raiseAlert(ctx, &Alert{
+                CreatedAt: now.Unix(),
+                Asp:       asp,
+                Metric:    "stream_quiet",
+                Direction: "drop",
+                Value:     math.Floor(quiet.Minutes()),
+                Message:   fmt.Sprintf("stream_quiet on %s: no events for %s", asp, quiet.Truncate(time.Minute)),
+            })
+        }
+        return true
+    })
+}
+
+// raiseAlert stores alert unless one for the same ASP, metric and direction
+// was raised within alertCooldown.
+func raiseAlert(ctx context.Context, alert *Alert) {
+    recent, err := DB.NewSelect().Model((*Alert)(nil)).
+        Where("asp = ? AND metric = ? AND direction = ? AND created_at > ?",
+            alert.Asp, alert.Metric, alert.Direction, alert.CreatedAt-int64(alertCooldown.Seconds())).
+        Exists(ctx)
+    if err != nil || recent {
+        return
+    }
+    if _, err := DB.NewInsert().Model(alert).Exec(ctx); err != nil {
+        log.Printf("Error storing alert: %v", err)
+        return
+    }
+    log.Printf("ALERT %s", alert.Message)
+}
+
+// notifyAlerts posts alerts to alertWebhookURL. Failed posts are retried on
+// later runs for an hour.
+func notifyAlerts(ctx context.Context) {
+    if alertWebhookURL == "" {
+        return
+    }
+    var alerts []Alert
+    err := DB.NewSelect().Model(&alerts).
+        Where("notified_at = 0 AND created_at > ?", time.Now().Add(-time.Hour).Unix()).
+        Order("id ASC").
+        Scan(ctx)
+    if err != nil {
+        log.Printf("Error fetching alerts to notify: %v", err)
+        return
+    }
+
+    client := &http.Client{Timeout: 10 * time.Second}
+    for i := range alerts {
+        a := &alerts[i]
+        body, _ := json.Marshal(a)
+        req, err := http.NewRequestWithContext(ctx, "POST", alertWebhookURL, bytes.NewReader(body))
+        if err != nil {
+            log.Printf("Error notifying alert %d: %v", a.ID, err)
+            return
+        }
+        req.Header.Set("Content-Type", "application/json")
+        resp, err := client.Do(req)
+        if err != nil {
+            log.Printf("Error notifying alert %d: %v", a.ID, err)
+            continue
+        }
+        resp.Body.Close()
+        if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+            log.Printf("Error notifying alert %d: webhook returned %d", a.ID, resp.StatusCode)
+            continue
+        }
+        a.NotifiedAt = time.Now().Unix()
+        DB.NewUpdate().Model(a).Column("notified_at").WherePK().Exec(ctx)
+    }
+}
+
+// StartAnomalyDetector checks metrics against their baselines, and streams
+// for silence, every anomalyInterval.
+func StartAnomalyDetector(ctx context.Context) {
+    ticker := time.NewTicker(anomalyInterval)
+    defer ticker.Stop()
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            detectAnomalies(ctx)
+            detectQuietStreams(ctx)
+            notifyAlerts(ctx)
+        }
+    }
+}
+
+// GetAlerts lists alerts newest first.
+func GetAlerts(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+    query := r.URL.Query()
+
+    limit := 50
+    if v := query.Get("limit"); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n <= 0 {
+            return badRequest("limit must be a positive integer")
+        }
+        limit = min(n, 500)
+    }
+    since, err := int64Param(r, "since")
+    if err != nil {
+        return err
+    }
+
+    q := filterASP(DB.NewSelect().Model((*Alert)(nil)), r)
+    if metrics := splitList(query.Get("metric")); len(metrics) > 0 {
+        q = q.Where("metric IN (?)", bun.In(metrics))
+    }
+    if since > 0 {
+        q = q.Where("created_at >= ?", since)
+    }
+
+    alerts := make([]Alert, 0)
+    if err := q.Order("id DESC").Limit(limit).Scan(ctx, &alerts); err != nil {
+        return err
+    }
+    return writeJSON(w, http.StatusOK, alerts)
+}
@@ -0,0 +1,37 @@
+package main
+
+import "testing"
+
+func TestZScore(t *testing.T) {
+    // The baseline alternates around 1 with a standard deviation of 1
+    series := func(current float64, hours int) []float64 {
+        values := []float64{current}
+        for i := 0; i < hours; i++ {
+            values = append(values, float64(i%2*2))
+        }
+        return values
+    }
+    flat := make([]float64, minBaselineHours+2)
+    flat[0] = 5
+
+    tests := []struct {
+        name         string
+        values       []float64
+        z, mean, std float64
+        ok           bool
+    }{
+        {"too little history", series(10, minBaselineHours-1), 0, 0, 0, false},
+        {"flat baseline", flat, 0, 0, 0, false},
+        {"spike", series(10, 2*minBaselineHours), 9, 1, 1, true},
+        {"drop", series(-1, 2*minBaselineHours), -2, 1, 1, true},
+        {"normal hour", series(1, 2*minBaselineHours), 0, 1, 1, true},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            z, mean, std, ok := zScore(tt.values)
+            if ok != tt.ok || z != tt.z || mean != tt.mean || std != tt.std {
+                t.Errorf("zScore = %v, %v, %v, %t; want %v, %v, %v, %t", z, mean, std, ok, tt.z, tt.mean, tt.std, tt.ok)
+            }
+        })
+    }
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+    "context"
+    "crypto/rand"
+    "encoding/hex"
+    "flag"
+    "fmt"
+    "log"
+    "os"
+    "text/tabwriter"
+    "time"
+)
+
+// runAPIKeys implements `arkexplorer apikey create|list|revoke`.
+func runAPIKeys(args []string) int {
+    usage := func() int {
+        fmt.Fprintln(os.Stderr, "usage: arkexplorer apikey create -name NAME [-rate N] [-burst N] | list | revoke -id ID")
+        return 2
+    }
+    if len(args) == 0 {
+        return usage()
+    }
+
+    fs := flag.NewFlagSet("apikey "+args[0], flag.ExitOnError)
+    name := fs.String("name", "", "Who the key is for")
+    rate := fs.Float64("rate", 50, "Sustained requests per second")
+    burst := fs.Int("burst", 600, "Bucket size in requests")
+    id := fs.Int64("id", 0, "Key id to revoke")
+    fs.Parse(args[1:])
+
+    if err := InitDB(); err != nil {
+        log.Printf("Database error: %v", err)
+        return 1
+    }
+    ctx := context.Background()
+    DB.NewCreateTable().Model((*APIKey)(nil)).IfNotExists().Exec(ctx)
+
+    switch args[0] {
+    case "create":
+        if *name == "" || *rate <= 0 || *burst <= 0 {
+            return usage()
+        }
+        b := make([]byte, 24)
+        rand.Read(b)
+        key := "ak_" + hex.EncodeToString(b)
+        k := &APIKey{
+            Name:      *name,
+            Prefix:    key[:11],
+            KeyHash:   hashAPIKey(key),
+            Rate:      *rate,
+            Burst:     *burst,
+            CreatedAt: time.Now().Unix(),
+        }
+        if _, err := DB.NewInsert().Model(k).Exec(ctx); err != nil {
+            log.Printf("Error creating key: %v", err)
+            return 1
+        }
+        // The key itself is not stored, so this is the only time it is shown
+        fmt.Printf("Created key %d for %s: %s\n", k.ID, k.Name, key)
+
+    case "list":
+        var keys []APIKey
+        if err := DB.NewSelect().Model(&keys).Order("id ASC").Scan(ctx); err != nil {
+            log.Printf("Error listing keys: %v", err)
+            return 1
+        }
+        tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+        fmt.Fprintln(tw, "ID\tNAME\tPREFIX\tRATE\tBURST\tCREATED\tSTATUS")
+        for _, k := range keys {
+            status := "active"
+            if k.RevokedAt > 0 {
+                status = "revoked " + time.Unix(k.RevokedAt, 0).UTC().Format("2006-01-02")
+            }
+            fmt.Fprintf(tw, "%d\t%s\t%s\t%g/s\t%d\t%s\t%s\n", k.ID, k.Name, k.Prefix, k.Rate, k.Burst,
+                time.Unix(k.CreatedAt, 0).UTC().Format("2006-01-02"), status)
+        }
+        tw.Flush()
+
+    case "revoke":
+        if *id == 0 {
+            return usage()
+        }
+        res, err := DB.NewUpdate().Model((*APIKey)(nil)).
+            Set("revoked_at = ?", time.Now().Unix()).
+            Where("id = ? AND revoked_at = 0", *id).
+            Exec(ctx)
+        if err != nil {
+            log.Printf("Error revoking key: %v", err)
+            return 1
+        }
+        if n, _ := res.RowsAffected(); n == 0 {
+            log.Printf("No active key with id %d", *id)
+            return 1
+        }
+        // The server picks this up on its next key reload, within a minute
+        fmt.Printf("Revoked key %d\n", *id)
+
+    default:
+        return usage()
+    }
+    return 0
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+    "encoding/hex"
+    "errors"
+    "strings"
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const bech32mConst = 0x2bc830a3
+
+// arkAddressScript decodes an Ark address (ark1... / tark1...) and returns the
+// P2TR script of its VTXO taproot key, which is what VTXO.Script stores. The
+// payload is version || server x-only key || vtxo taproot key, as built by
+// generateArkAddress in the frontend.
+func arkAddressScript(address string) (string, error) {
+    hrp, data, err := decodeBech32m(address)
+    if err != nil {
+        return "", err
+    }
+    if hrp != "ark" && hrp != "tark" {
+        return "", errors.New("not an ark address")
+    }
+
+    payload, err := convertBits(data, 5, 8)
+    if err != nil {
+        return "", err
+    }
+    if len(payload) != 65 {
+        return "", errors.New("invalid ark address length")
+    }
+
+    return "5120" + hex.EncodeToString(payload[33:]), nil
+}
+
+func decodeBech32m(s string) (string, []byte, error) {
+    if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+        return "", nil, errors.New("mixed case address")
+    }
+    s = strings.ToLower(s)
+
+    pos := strings.LastIndexByte(s, '1')
+    if pos < 1 || pos+7 > len(s) {
+        return "", nil, errors.New("invalid bech32 separator position")
+    }
+
+    hrp := s[:pos]
+    data := make([]byte, 0, len(s)-pos-1)
+    for _, c := range s[pos+1:] {
+        idx := strings.IndexRune(bech32Charset, c)
+        if idx < 0 {
+            return "", nil, errors.New("invalid bech32 character")
+        }
+        data = append(data, byte(idx))
+    }
+
+    values := make([]byte, 0, len(hrp)*2+1+len(data))
+    for _, c := range hrp {
+        values = append(values, byte(c>>5))
+    }
+    values = append(values, 0)
+    for _, c := range hrp {
+        values = append(values, byte(c&31))
+    }
+    values = append(values, data...)
+    if bech32Polymod(values) != bech32mConst {
+        return "", nil, errors.New("invalid bech32m checksum")
+    }
+
+    return hrp, data[:len(data)-6], nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+    gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+    chk := uint32(1)
+    for _, v := range values {
+        top := chk >> 25
+        chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+        for i := 0; i < 5; i++ {
+            if (top>>uint(i))&1 == 1 {
+                chk ^= gen[i]
+            }
+        }
+    }
+    return chk
+}
+
+func convertBits(data []byte, from, to uint) ([]byte, error) {
+    var acc uint32
+    var bits uint
+    maxv := uint32(1)<<to - 1
+    out := make([]byte, 0, len(data)*int(from)/int(to))
+    for _, v := range data {
+        acc = acc<<from | uint32(v)
+        bits += from
+        for bits >= to {
+            bits -= to
+            out = append(out, byte(acc>>bits&maxv))
+        }
+    }
+    if bits >= from || (acc<<(to-bits))&maxv != 0 {
+        return nil, errors.New("invalid padding")
+    }
+    return out, nil
+}
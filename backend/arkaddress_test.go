@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestArkAddressScript(t *testing.T) {
+    // version 0, server key 0x01..0x20, VTXO key 0xa0..0xbf
+    const script = "5120a0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"
+    const mainnet = "ark1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0jpg9p5236ffdx5752n24t4jk6ataskxet8d94k6mm3wd6hw7tm04l39vdak"
+
+    tests := []struct {
+        name, address, script string
+        ok                    bool
+    }{
+        {"mainnet", mainnet, script, true},
+        {"testnet", "tark1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0jpg9p5236ffdx5752n24t4jk6ataskxet8d94k6mm3wd6hw7tm04lhh86y7", script, true},
+        {"upper case", "ARK1QQQSYQCYQ5RQWZQFPG9SCRGWPUGPZYSNZS23V9CCRYDPK8QARC0JPG9P5236FFDX5752N24T4JK6ATASKXET8D94K6MM3WD6HW7TM04L39VDAK", script, true},
+        {"mixed case", "Ark1" + mainnet[4:], "", false},
+        {"bad checksum", mainnet[:len(mainnet)-1] + "q", "", false},
+        {"other hrp", "bc1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0jpg9p5236ffdx5752n24t4jk6ataskxet8d94k6mm3wd6hw7tm04lmf6k77", "", false},
+        {"short payload", "ark1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0jq93vw2t", "", false},
+        {"no separator", "arkqqqsyqcyq5rq", "", false},
+        {"invalid character", "ark1qqqsyqcyqbio", "", false},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := arkAddressScript(tt.address)
+            if (err == nil) != tt.ok || got != tt.script {
+                t.Errorf("arkAddressScript = %q, %v; want %q, ok=%t", got, err, tt.script, tt.ok)
+            }
+        })
+    }
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "net/http"
+    "strings"
+    "time"
+
+    "github.com/uptrace/bun"
+)
+
+// defaultASP is the operator id used when no -asp flag is given and for rows
+// ingested before multi-ASP support existed.
+const defaultASP = "arkade"
+
+type ASPSource struct {
+    ID  string
+    URL string
+}
+
+// aspFlag collects repeated -asp id=url flags.
+type aspFlag []ASPSource
+
+func (f *aspFlag) String() string {
+    parts := make([]string, 0, len(*f))
+    for _, s := range *f {
+        parts = append(parts, s.ID+"="+s.URL)
+    }
+    return strings.Join(parts, ",")
+}
+
+func (f *aspFlag) Set(value string) error {
+    id, url, ok := strings.Cut(value, "=")
+    if !ok || id == "" || url == "" {
+        return fmt.Errorf("expected id=url, got %q", value)
+    }
+    for _, s := range *f {
+        if s.ID == id {
+            return fmt.Errorf("duplicate asp id %q", id)
+        }
+    }
+    *f = append(*f, ASPSource{ID: id, URL: url})
+    return nil
+}
+
+// filterASP restricts q to a single operator when the request carries ?asp=.
+func filterASP(q *bun.SelectQuery, r *http.Request) *bun.SelectQuery {
+    return whereASP(q, r.URL.Query().Get("asp"))
+}
+
+// whereASP restricts q to asp unless it is empty.
+func whereASP(q *bun.SelectQuery, asp string) *bun.SelectQuery {
+    if asp != "" {
+        q = q.Where("asp = ?", asp)
+    }
+    return q
+}
+
+// knownASPs lists every operator that has at least one stored VTXO.
+func knownASPs(ctx context.Context) []string {
+    var asps []string
+    DB.NewSelect().
+        Model((*VTXO)(nil)).
+        ColumnExpr("DISTINCT asp").
+        Order("asp ASC").
+        Scan(ctx, &asps)
+    return asps
+}
+
+// parseTimeframe reads ?timeframe=, defaulting to 24h and rejecting values the
+// API does not know.
+func parseTimeframe(r *http.Request) (string, error) {
+    switch tf := r.URL.Query().Get("timeframe"); tf {
+    case "":
+        return "24h", nil
+    case "24h", "1w", "1month", "all time":
+        return tf, nil
+    default:
+        return "", badRequest("timeframe must be one of 24h, 1w, 1month, all time")
+    }
+}
+
+// timeframeSeconds maps the timeframe query values shared by the API to a
+// window length. "all time" returns 0.
+func timeframeSeconds(timeframe string) int64 {
+    switch timeframe {
+    case "1w":
+        return 7 * 24 * 3600
+    case "1month":
+        return 30 * 24 * 3600
+    case "all time":
+        return 0
+    default:
+        return 24 * 3600
+    }
+}
+
+// bucketDateFormat returns the SQL expression that buckets created_at by
+// hour for the 24h timeframe and by day otherwise.
+func bucketDateFormat(timeframe string) string {
+    if timeframe == "24h" {
+        return "DATE_FORMAT(FROM_UNIXTIME(created_at), '%Y-%m-%d %H:00')"
+    }
+    return "DATE(FROM_UNIXTIME(created_at))"
+}
+
+type ASPMetrics struct {
+    Asp               string  `bun:"asp" json:"asp"`
+    NetworkLiquidity  float64 `bun:"network_liquidity" json:"networkLiquidity"`
+    UnspentCount      int     `bun:"unspent_count" json:"unspentCount"`
+    OnboardingVolume  float64 `bun:"onboarding_volume" json:"onboardingVolume"`
+    OffboardingVolume float64 `bun:"offboarding_volume" json:"offboardingVolume"`
+    VirtualTxVolume   float64 `bun:"virtual_tx_volume" json:"virtualTxVolume"`
+    VirtualTxCount    int     `bun:"virtual_tx_count" json:"virtualTxCount"`
+    LiquidityShare    float64 `bun:"-" json:"liquidityShare"`
+}
+
+// aspBreakdown groups current liquidity and the flows since periodStart by ASP.
+func aspBreakdown(ctx context.Context, periodStart int64) ([]ASPMetrics, error) {
+    results := make([]ASPMetrics, 0)
+    err := DB.NewSelect().
+        Model((*VTXO)(nil)).
+        ColumnExpr("asp").
+        ColumnExpr("COALESCE(SUM(CASE WHEN is_spent = 0 THEN amount ELSE 0 END), 0) / 100000000.0 AS network_liquidity").
+        ColumnExpr("COUNT(CASE WHEN is_spent = 0 THEN 1 END) AS unspent_count").
+        ColumnExpr("COALESCE(SUM(CASE WHEN tx_type = 'onboard' AND created_at >= ? THEN amount ELSE 0 END), 0) / 100000000.0 AS onboarding_volume", periodStart).
+        ColumnExpr("COALESCE(SUM(CASE WHEN tx_type = 'offboard' AND created_at >= ? THEN amount ELSE 0 END), 0) / 100000000.0 AS offboarding_volume", periodStart).
+        ColumnExpr("COALESCE(SUM(CASE WHEN tx_type = 'virtual' AND created_at >= ? THEN amount ELSE 0 END), 0) / 100000000.0 AS virtual_tx_volume", periodStart).
+        ColumnExpr("COUNT(CASE WHEN tx_type = 'virtual' AND created_at >= ? THEN 1 END) AS virtual_tx_count", periodStart).
+        Group("asp").
+        Order("asp ASC").
+        Scan(ctx, &results)
+    return results, err
+}
+
+// GetASPComparison returns liquidity and volume side by side for every ASP.
+func GetASPComparison(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+
+    now := time.Now().Unix()
+    currentStart := int64(0)
+    if period := timeframeSeconds(timeframe); period > 0 {
+        currentStart = now - period
+    }
+
+    results, err := aspBreakdown(ctx, currentStart)
+    if err != nil {
+        return err
+    }
+
+    var total float64
+    for _, m := range results {
+        total += m.NetworkLiquidity
+    }
+    if total > 0 {
+        for i := range results {
+            results[i].LiquidityShare = results[i].NetworkLiquidity / total * 100
+        }
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "asps":      results,
+    })
+}
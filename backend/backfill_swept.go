@@ -91,6 +91,7 @@ func BackfillSweptVTXOs() {
                 result, err := DB.NewUpdate().
                     Model((*VTXO)(nil)).
                     Set("tx_type = ?", "offboard").
+                    Set("is_swept = ?", true).
                     Where("txid = ? AND vout = ?", txid, int(vout)).
                     Exec(ctx)
                 
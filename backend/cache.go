@@ -0,0 +1,141 @@
+package main
+
+import (
+    "bytes"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+)
+
+// maxCacheEntries bounds the response cache; arbitrary asp values could
+// otherwise grow it without limit.
+const maxCacheEntries = 256
+
+type cachedResponse struct {
+    body        []byte
+    etag        string
+    contentType string
+    generation  uint64
+    expires     time.Time
+}
+
+// responseCache holds rendered aggregate responses keyed by path and query.
+// The ingester bumps generation whenever it applies VTXOs, which drops every
+// entry; the TTL bounds staleness of time-relative windows in between.
+var responseCache = struct {
+    sync.Mutex
+    generation uint64
+    entries    map[string]*cachedResponse
+}{entries: make(map[string]*cachedResponse)}
+
+// invalidateResponseCache is called after the ingester writes VTXOs.
+func invalidateResponseCache() {
+    responseCache.Lock()
+    responseCache.generation++
+    responseCache.entries = make(map[string]*cachedResponse)
+    responseCache.Unlock()
+}
+
+func lookupResponse(key string) (*cachedResponse, uint64) {
+    responseCache.Lock()
+    defer responseCache.Unlock()
+    e := responseCache.entries[key]
+    if e != nil && (e.generation != responseCache.generation || time.Now().After(e.expires)) {
+        delete(responseCache.entries, key)
+        e = nil
+    }
+    return e, responseCache.generation
+}
+
+func storeResponse(key string, e *cachedResponse) {
+    responseCache.Lock()
+    defer responseCache.Unlock()
+    // Computed before an invalidation: the data may already be stale
+    if e.generation != responseCache.generation {
+        return
+    }
+    if len(responseCache.entries) >= maxCacheEntries {
+        responseCache.entries = make(map[string]*cachedResponse)
+    }
+    responseCache.entries[key] = e
+}
+
+// bufferedResponse captures a handler's response so it can be cached.
+type bufferedResponse struct {
+    header http.Header
+    status int
+    body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header         { return b.header }
+func (b *bufferedResponse) WriteHeader(code int)        { b.status = code }
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+// etagMatches reports whether an If-None-Match header matches etag.
+func etagMatches(header, etag string) bool {
+    for _, tag := range strings.Split(header, ",") {
+        tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+        if tag == etag || tag == "*" {
+            return true
+        }
+    }
+    return false
+}
+
+// cached serves GET responses from responseCache for up to ttl, and sets
+// ETag and Cache-Control so clients can revalidate with If-None-Match.
+func cached(ttl time.Duration) middleware {
+    return func(next http.HandlerFunc) http.HandlerFunc {
+        return func(w http.ResponseWriter, r *http.Request) {
+            if r.Method != http.MethodGet && r.Method != http.MethodHead {
+                next(w, r)
+                return
+            }
+
+            // Query().Encode sorts keys so equivalent queries share an entry
+            key := r.URL.Path + "?" + r.URL.Query().Encode()
+            e, generation := lookupResponse(key)
+            if e != nil {
+                responseCacheLookups.WithLabelValues("hit").Inc()
+            } else {
+                responseCacheLookups.WithLabelValues("miss").Inc()
+                buf := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+                next(buf, r)
+
+                if buf.status != http.StatusOK {
+                    // Errors are passed through uncached
+                    for k, v := range buf.header {
+                        w.Header()[k] = v
+                    }
+                    w.WriteHeader(buf.status)
+                    w.Write(buf.body.Bytes())
+                    return
+                }
+
+                sum := sha256.Sum256(buf.body.Bytes())
+                e = &cachedResponse{
+                    body:        buf.body.Bytes(),
+                    etag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+                    contentType: buf.header.Get("Content-Type"),
+                    generation:  generation,
+                    expires:     time.Now().Add(ttl),
+                }
+                storeResponse(key, e)
+            }
+
+            w.Header().Set("ETag", e.etag)
+            w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(ttl.Seconds())))
+            if etagMatches(r.Header.Get("If-None-Match"), e.etag) {
+                w.WriteHeader(http.StatusNotModified)
+                return
+            }
+            w.Header().Set("Content-Type", e.contentType)
+            w.WriteHeader(http.StatusOK)
+            w.Write(e.body)
+        }
+    }
+}
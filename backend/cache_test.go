@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestEtagMatches(t *testing.T) {
+    const etag = `"abc"`
+    tests := []struct {
+        header string
+        want   bool
+    }{
+        {``, false},
+        {`"abc"`, true},
+        {`"xyz"`, false},
+        {`W/"abc"`, true},
+        {`"xyz", "abc"`, true},
+        {`"xyz",W/"abc"`, true},
+        {`*`, true},
+        {`abc`, false},
+    }
+    for _, tt := range tests {
+        if got := etagMatches(tt.header, etag); got != tt.want {
+            t.Errorf("etagMatches(%q) = %t, want %t", tt.header, got, tt.want)
+        }
+    }
+}
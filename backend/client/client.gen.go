@@ -0,0 +1,5557 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApiKeyScopes = "ApiKey.Scopes"
+)
+
+// Defines values for AlertDirection.
+const (
+	Drop  AlertDirection = "drop"
+	Spike AlertDirection = "spike"
+)
+
+// Defines values for AlertMetric.
+const (
+	LiquidityChange   AlertMetric = "liquidity_change"
+	OffboardingVolume AlertMetric = "offboarding_volume"
+	OnboardingVolume  AlertMetric = "onboarding_volume"
+	StreamQuiet       AlertMetric = "stream_quiet"
+	VirtualTxCount    AlertMetric = "virtual_tx_count"
+	VirtualVolume     AlertMetric = "virtual_volume"
+)
+
+// Defines values for LifetimeClassClass.
+const (
+	LifetimeClassClassOffboarded LifetimeClassClass = "offboarded"
+	LifetimeClassClassRefreshed  LifetimeClassClass = "refreshed"
+	LifetimeClassClassSpent      LifetimeClassClass = "spent"
+	LifetimeClassClassSwept      LifetimeClassClass = "swept"
+)
+
+// Defines values for ReadinessStatus.
+const (
+	Ok          ReadinessStatus = "ok"
+	Unavailable ReadinessStatus = "unavailable"
+)
+
+// Defines values for StatsCompare.
+const (
+	StatsCompareMonth    StatsCompare = "month"
+	StatsComparePrevious StatsCompare = "previous"
+	StatsCompareWeek     StatsCompare = "week"
+)
+
+// Defines values for WatchKind.
+const (
+	Address  WatchKind = "address"
+	Outpoint WatchKind = "outpoint"
+	Script   WatchKind = "script"
+)
+
+// Defines values for WebhookDeliveryEvent.
+const (
+	WebhookDeliveryEventCreated  WebhookDeliveryEvent = "created"
+	WebhookDeliveryEventExpiring WebhookDeliveryEvent = "expiring"
+	WebhookDeliveryEventSpent    WebhookDeliveryEvent = "spent"
+	WebhookDeliveryEventSwept    WebhookDeliveryEvent = "swept"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Cancelled WebhookDeliveryStatus = "cancelled"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for Timeframe.
+const (
+	TimeframeAllTime Timeframe = "all time"
+	TimeframeN1month Timeframe = "1month"
+	TimeframeN1w     Timeframe = "1w"
+	TimeframeN24h    Timeframe = "24h"
+)
+
+// Defines values for GetAmountDistributionParamsTimeframe.
+const (
+	GetAmountDistributionParamsTimeframeAllTime GetAmountDistributionParamsTimeframe = "all time"
+	GetAmountDistributionParamsTimeframeN1month GetAmountDistributionParamsTimeframe = "1month"
+	GetAmountDistributionParamsTimeframeN1w     GetAmountDistributionParamsTimeframe = "1w"
+	GetAmountDistributionParamsTimeframeN24h    GetAmountDistributionParamsTimeframe = "24h"
+)
+
+// Defines values for GetConcentrationParamsTimeframe.
+const (
+	GetConcentrationParamsTimeframeAllTime GetConcentrationParamsTimeframe = "all time"
+	GetConcentrationParamsTimeframeN1month GetConcentrationParamsTimeframe = "1month"
+	GetConcentrationParamsTimeframeN1w     GetConcentrationParamsTimeframe = "1w"
+	GetConcentrationParamsTimeframeN24h    GetConcentrationParamsTimeframe = "24h"
+)
+
+// Defines values for GetFeeAnalyticsParamsTimeframe.
+const (
+	GetFeeAnalyticsParamsTimeframeAllTime GetFeeAnalyticsParamsTimeframe = "all time"
+	GetFeeAnalyticsParamsTimeframeN1month GetFeeAnalyticsParamsTimeframe = "1month"
+	GetFeeAnalyticsParamsTimeframeN1w     GetFeeAnalyticsParamsTimeframe = "1w"
+	GetFeeAnalyticsParamsTimeframeN24h    GetFeeAnalyticsParamsTimeframe = "24h"
+)
+
+// Defines values for GetFlowsParamsTimeframe.
+const (
+	GetFlowsParamsTimeframeAllTime GetFlowsParamsTimeframe = "all time"
+	GetFlowsParamsTimeframeN1month GetFlowsParamsTimeframe = "1month"
+	GetFlowsParamsTimeframeN1w     GetFlowsParamsTimeframe = "1w"
+	GetFlowsParamsTimeframeN24h    GetFlowsParamsTimeframe = "24h"
+)
+
+// Defines values for GetLifetimesParamsTimeframe.
+const (
+	GetLifetimesParamsTimeframeAllTime GetLifetimesParamsTimeframe = "all time"
+	GetLifetimesParamsTimeframeN1month GetLifetimesParamsTimeframe = "1month"
+	GetLifetimesParamsTimeframeN1w     GetLifetimesParamsTimeframe = "1w"
+	GetLifetimesParamsTimeframeN24h    GetLifetimesParamsTimeframe = "24h"
+)
+
+// Defines values for GetRefreshAnalyticsParamsTimeframe.
+const (
+	GetRefreshAnalyticsParamsTimeframeAllTime GetRefreshAnalyticsParamsTimeframe = "all time"
+	GetRefreshAnalyticsParamsTimeframeN1month GetRefreshAnalyticsParamsTimeframe = "1month"
+	GetRefreshAnalyticsParamsTimeframeN1w     GetRefreshAnalyticsParamsTimeframe = "1w"
+	GetRefreshAnalyticsParamsTimeframeN24h    GetRefreshAnalyticsParamsTimeframe = "24h"
+)
+
+// Defines values for GetRoundStatsParamsTimeframe.
+const (
+	GetRoundStatsParamsTimeframeAllTime GetRoundStatsParamsTimeframe = "all time"
+	GetRoundStatsParamsTimeframeN1month GetRoundStatsParamsTimeframe = "1month"
+	GetRoundStatsParamsTimeframeN1w     GetRoundStatsParamsTimeframe = "1w"
+	GetRoundStatsParamsTimeframeN24h    GetRoundStatsParamsTimeframe = "24h"
+)
+
+// Defines values for GetASPComparisonParamsTimeframe.
+const (
+	GetASPComparisonParamsTimeframeAllTime GetASPComparisonParamsTimeframe = "all time"
+	GetASPComparisonParamsTimeframeN1month GetASPComparisonParamsTimeframe = "1month"
+	GetASPComparisonParamsTimeframeN1w     GetASPComparisonParamsTimeframe = "1w"
+	GetASPComparisonParamsTimeframeN24h    GetASPComparisonParamsTimeframe = "24h"
+)
+
+// Defines values for GetExpiryCalendarParamsBucket.
+const (
+	Day  GetExpiryCalendarParamsBucket = "day"
+	Hour GetExpiryCalendarParamsBucket = "hour"
+)
+
+// Defines values for ExportDatasetParamsFormat.
+const (
+	Csv    ExportDatasetParamsFormat = "csv"
+	Ndjson ExportDatasetParamsFormat = "ndjson"
+)
+
+// Defines values for ExportDatasetParamsTimeframe.
+const (
+	ExportDatasetParamsTimeframeAllTime ExportDatasetParamsTimeframe = "all time"
+	ExportDatasetParamsTimeframeN1month ExportDatasetParamsTimeframe = "1month"
+	ExportDatasetParamsTimeframeN1w     ExportDatasetParamsTimeframe = "1w"
+	ExportDatasetParamsTimeframeN24h    ExportDatasetParamsTimeframe = "24h"
+)
+
+// Defines values for ExportDatasetParamsDataset.
+const (
+	ExportDatasetParamsDatasetStats  ExportDatasetParamsDataset = "stats"
+	ExportDatasetParamsDatasetTrends ExportDatasetParamsDataset = "trends"
+	ExportDatasetParamsDatasetVtxos  ExportDatasetParamsDataset = "vtxos"
+)
+
+// Defines values for GetStatsParamsTimeframe.
+const (
+	GetStatsParamsTimeframeAllTime GetStatsParamsTimeframe = "all time"
+	GetStatsParamsTimeframeN1month GetStatsParamsTimeframe = "1month"
+	GetStatsParamsTimeframeN1w     GetStatsParamsTimeframe = "1w"
+	GetStatsParamsTimeframeN24h    GetStatsParamsTimeframe = "24h"
+)
+
+// Defines values for GetStatsParamsCompare.
+const (
+	GetStatsParamsCompareMonth    GetStatsParamsCompare = "month"
+	GetStatsParamsComparePrevious GetStatsParamsCompare = "previous"
+	GetStatsParamsCompareWeek     GetStatsParamsCompare = "week"
+)
+
+// Defines values for GetTrendsParamsTimeframe.
+const (
+	GetTrendsParamsTimeframeAllTime GetTrendsParamsTimeframe = "all time"
+	GetTrendsParamsTimeframeN1month GetTrendsParamsTimeframe = "1month"
+	GetTrendsParamsTimeframeN1w     GetTrendsParamsTimeframe = "1w"
+	GetTrendsParamsTimeframeN24h    GetTrendsParamsTimeframe = "24h"
+)
+
+// Defines values for GetTrendsParamsBreakdown.
+const (
+	GetTrendsParamsBreakdownAsp GetTrendsParamsBreakdown = "asp"
+)
+
+// ASPComparison defines model for ASPComparison.
+type ASPComparison struct {
+	Asps      []ASPMetrics `json:"asps"`
+	Timeframe string       `json:"timeframe"`
+}
+
+// ASPMetrics defines model for ASPMetrics.
+type ASPMetrics struct {
+	Asp string `json:"asp"`
+
+	// LiquidityShare Percent of total liquidity
+	LiquidityShare    float32 `json:"liquidityShare"`
+	NetworkLiquidity  float32 `json:"networkLiquidity"`
+	OffboardingVolume float32 `json:"offboardingVolume"`
+	OnboardingVolume  float32 `json:"onboardingVolume"`
+	UnspentCount      int     `json:"unspentCount"`
+	VirtualTxCount    int     `json:"virtualTxCount"`
+	VirtualTxVolume   float32 `json:"virtualTxVolume"`
+}
+
+// Alert defines model for Alert.
+type Alert struct {
+	Asp       string         `json:"asp"`
+	CreatedAt int64          `json:"createdAt"`
+	Direction AlertDirection `json:"direction"`
+	Id        int64          `json:"id"`
+
+	// Mean Mean of the hourly baseline; 0 for stream_quiet
+	Mean    float32     `json:"mean"`
+	Message string      `json:"message"`
+	Metric  AlertMetric `json:"metric"`
+	StdDev  float32     `json:"stdDev"`
+
+	// Value The metric over the last hour; for stream_quiet, minutes without events
+	Value  float32 `json:"value"`
+	ZScore float32 `json:"zScore"`
+}
+
+// AlertDirection defines model for Alert.Direction.
+type AlertDirection string
+
+// AlertMetric defines model for Alert.Metric.
+type AlertMetric string
+
+// AmountBucket defines model for AmountBucket.
+type AmountBucket struct {
+	Label string `json:"label"`
+
+	// Max Exclusive upper bound in sats; 0 for the open-ended last bucket
+	Max          int64   `json:"max"`
+	UnspentCount int     `json:"unspentCount"`
+	UnspentValue float32 `json:"unspentValue"`
+	VirtualCount int     `json:"virtualCount"`
+	VirtualValue float32 `json:"virtualValue"`
+}
+
+// AmountDistribution defines model for AmountDistribution.
+type AmountDistribution struct {
+	Buckets   []AmountBucket `json:"buckets"`
+	Timeframe string         `json:"timeframe"`
+	Timestamp int64          `json:"timestamp"`
+
+	// VirtualAmounts Virtual transfer amounts in sats
+	VirtualAmounts struct {
+		Count  int     `json:"count"`
+		Mean   float32 `json:"mean"`
+		Median int64   `json:"median"`
+		P10    int64   `json:"p10"`
+		P25    int64   `json:"p25"`
+		P75    int64   `json:"p75"`
+		P90    int64   `json:"p90"`
+		P99    int64   `json:"p99"`
+	} `json:"virtualAmounts"`
+}
+
+// Concentration defines model for Concentration.
+type Concentration struct {
+	Current   ConcentrationSnapshot   `json:"current"`
+	Series    []ConcentrationSnapshot `json:"series"`
+	Timeframe string                  `json:"timeframe"`
+}
+
+// ConcentrationSnapshot defines model for ConcentrationSnapshot.
+type ConcentrationSnapshot struct {
+	// Asp Empty for the whole network
+	Asp string `json:"asp"`
+
+	// Gini Gini coefficient of per-script balances, 0 to 1
+	Gini float32 `json:"gini"`
+
+	// Holders Distinct scripts holding unspent VTXOs
+	Holders       int   `json:"holders"`
+	LiquiditySats int64 `json:"liquiditySats"`
+	Timestamp     int64 `json:"timestamp"`
+
+	// Top100Share Percent of liquidity held by the 100 largest scripts
+	Top100Share float32 `json:"top100Share"`
+
+	// Top10Share Percent of liquidity held by the 10 largest scripts
+	Top10Share float32 `json:"top10Share"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error struct {
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		RequestId string `json:"requestId"`
+	} `json:"error"`
+}
+
+// ExpiryBucket defines model for ExpiryBucket.
+type ExpiryBucket struct {
+	Amount      float32 `json:"amount"`
+	Count       int     `json:"count"`
+	DisplayDate string  `json:"displayDate"`
+}
+
+// ExpiryCalendar defines model for ExpiryCalendar.
+type ExpiryCalendar struct {
+	AtRisk         []VTXO         `json:"atRisk"`
+	Bucket         string         `json:"bucket"`
+	Calendar       []ExpiryBucket `json:"calendar"`
+	Days           int            `json:"days"`
+	ExpiredCount   int            `json:"expiredCount"`
+	ExpiredVolume  float32        `json:"expiredVolume"`
+	ExpiringVolume float32        `json:"expiringVolume"`
+	Timestamp      int64          `json:"timestamp"`
+}
+
+// FeeAnalytics defines model for FeeAnalytics.
+type FeeAnalytics struct {
+	Ark FeeSummary `json:"ark"`
+
+	// Rounds Forfeited inputs minus outputs of rounds. Boarding and exits settle on L1, which is not seen, so this is not a fee.
+	Rounds RoundNetFlow `json:"rounds"`
+	Series []struct {
+		ArkFees     float32 `json:"arkFees"`
+		ArkTxCount  int     `json:"arkTxCount"`
+		ArkVolume   float32 `json:"arkVolume"`
+		DisplayDate string  `json:"displayDate"`
+
+		// FeeRateBps arkFees as basis points of arkVolume
+		FeeRateBps *float32 `json:"feeRateBps"`
+		RoundCount int      `json:"roundCount"`
+
+		// RoundNetFlow Forfeited inputs minus outputs of rounds, in BTC
+		RoundNetFlow float32 `json:"roundNetFlow"`
+	} `json:"series"`
+	Timeframe string `json:"timeframe"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// FeeSummary defines model for FeeSummary.
+type FeeSummary struct {
+	Count int `json:"count"`
+
+	// FeePaying Transactions with a positive fee
+	FeePaying int `json:"feePaying"`
+
+	// FeeRateBps totalFees as basis points of volume
+	FeeRateBps *float32 `json:"feeRateBps"`
+
+	// MeanFeeSats Sats per transaction
+	MeanFeeSats float32 `json:"meanFeeSats"`
+	P50FeeSats  int64   `json:"p50FeeSats"`
+	P90FeeSats  int64   `json:"p90FeeSats"`
+	P99FeeSats  int64   `json:"p99FeeSats"`
+
+	// TotalFees BTC
+	TotalFees float32 `json:"totalFees"`
+
+	// Volume Output value in BTC
+	Volume float32 `json:"volume"`
+}
+
+// FlowStep Amounts in BTC; outflows are negative, so endingLiquidity is startingLiquidity plus every flow
+type FlowStep struct {
+	CooperativeExits float32 `json:"cooperativeExits"`
+	DisplayDate      *string `json:"displayDate,omitempty"`
+	EndingLiquidity  float32 `json:"endingLiquidity"`
+	Onboarding       float32 `json:"onboarding"`
+
+	// RefreshForfeited VTXOs spent by a refresh, including swept ones
+	RefreshForfeited float32 `json:"refreshForfeited"`
+
+	// RefreshReissued Outputs of refreshing transactions, up to what they refreshed
+	RefreshReissued float32 `json:"refreshReissued"`
+
+	// Start Unix seconds
+	Start             int64   `json:"start"`
+	StartingLiquidity float32 `json:"startingLiquidity"`
+
+	// Sweeps VTXOs swept by the ASP and not refreshed, at expiry
+	Sweeps           float32 `json:"sweeps"`
+	TransfersCreated float32 `json:"transfersCreated"`
+	TransfersSpent   float32 `json:"transfersSpent"`
+	UnilateralExits  float32 `json:"unilateralExits"`
+}
+
+// Flows defines model for Flows.
+type Flows struct {
+	Balanced bool  `json:"balanced"`
+	From     int64 `json:"from"`
+
+	// MeasuredEndingLiquidity Liquidity at `to`, measured from unspent VTXOs rather than the flows
+	MeasuredEndingLiquidity float32 `json:"measuredEndingLiquidity"`
+
+	// Residual measuredEndingLiquidity minus summary.endingLiquidity
+	Residual float32    `json:"residual"`
+	Series   []FlowStep `json:"series"`
+
+	// Summary Amounts in BTC; outflows are negative, so endingLiquidity is startingLiquidity plus every flow
+	Summary   FlowStep `json:"summary"`
+	Timeframe string   `json:"timeframe"`
+	Timestamp int64    `json:"timestamp"`
+	To        int64    `json:"to"`
+}
+
+// HistogramBucket defines model for HistogramBucket.
+type HistogramBucket struct {
+	Label string `json:"label"`
+
+	// Max Exclusive upper bound; 0 for the open-ended last bucket
+	Max int64 `json:"max"`
+}
+
+// LifetimeClass defines model for LifetimeClass.
+type LifetimeClass struct {
+	Class LifetimeClassClass `json:"class"`
+	Count int                `json:"count"`
+
+	// Histogram Counts aligned with buckets
+	Histogram   []int   `json:"histogram"`
+	MeanSeconds float32 `json:"meanSeconds"`
+	P50Seconds  int64   `json:"p50Seconds"`
+	P90Seconds  int64   `json:"p90Seconds"`
+	P99Seconds  int64   `json:"p99Seconds"`
+	Volume      float32 `json:"volume"`
+}
+
+// LifetimeClassClass defines model for LifetimeClass.Class.
+type LifetimeClassClass string
+
+// Lifetimes defines model for Lifetimes.
+type Lifetimes struct {
+	// Buckets Lifetime buckets in seconds
+	Buckets   []HistogramBucket `json:"buckets"`
+	Classes   []LifetimeClass   `json:"classes"`
+	Timeframe string            `json:"timeframe"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// MetricChange defines model for MetricChange.
+type MetricChange struct {
+	Current float32  `json:"current"`
+	Delta   *float32 `json:"delta"`
+
+	// Percent Null without a comparison window or when previous is zero
+	Percent  *float32 `json:"percent"`
+	Previous *float32 `json:"previous"`
+}
+
+// Percentiles defines model for Percentiles.
+type Percentiles struct {
+	Mean float32 `json:"mean"`
+	P50  int64   `json:"p50"`
+	P90  int64   `json:"p90"`
+	P99  int64   `json:"p99"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	Database struct {
+		Error     *string `json:"error,omitempty"`
+		LatencyMs int64   `json:"latencyMs"`
+		Ok        bool    `json:"ok"`
+	} `json:"database"`
+	Status  ReadinessStatus `json:"status"`
+	Streams []struct {
+		Asp                       string   `json:"asp"`
+		Connected                 bool     `json:"connected"`
+		DisconnectedForSeconds    *float32 `json:"disconnectedForSeconds,omitempty"`
+		LastEventAgeSeconds       float32  `json:"lastEventAgeSeconds"`
+		LastStoredEventAgeSeconds *float32 `json:"lastStoredEventAgeSeconds,omitempty"`
+		Ok                        bool     `json:"ok"`
+		Reason                    *string  `json:"reason,omitempty"`
+	} `json:"streams"`
+}
+
+// ReadinessStatus defines model for Readiness.Status.
+type ReadinessStatus string
+
+// RecentTransaction defines model for RecentTransaction.
+type RecentTransaction struct {
+	Asp       string `json:"asp"`
+	CreatedAt int64  `json:"createdAt"`
+	TxType    string `json:"txType"`
+	Txid      string `json:"txid"`
+}
+
+// RefreshAnalytics defines model for RefreshAnalytics.
+type RefreshAnalytics struct {
+	Series    []RefreshMetrics `json:"series"`
+	Summary   RefreshMetrics   `json:"summary"`
+	Timeframe string           `json:"timeframe"`
+	Timestamp int64            `json:"timestamp"`
+}
+
+// RefreshMetrics defines model for RefreshMetrics.
+type RefreshMetrics struct {
+	// AvgSecondsBeforeExpiry Mean time left until expiry when refreshed; negative for late refreshes
+	AvgSecondsBeforeExpiry *float32 `json:"avgSecondsBeforeExpiry"`
+
+	// DisplayDate Bucket, omitted in the summary
+	DisplayDate *string `json:"displayDate,omitempty"`
+
+	// RefreshCount VTXOs spent by a refresh, including swept ones it recovered
+	RefreshCount                int     `json:"refreshCount"`
+	RefreshVolume               float32 `json:"refreshVolume"`
+	RefreshedBeforeExpiryVolume float32 `json:"refreshedBeforeExpiryVolume"`
+
+	// RefreshedShare Percent of refreshed or swept liquidity that was refreshed before expiry
+	RefreshedShare *float32 `json:"refreshedShare"`
+
+	// SweptCount VTXOs swept by the ASP and not refreshed since, dated by expiry
+	SweptCount  int     `json:"sweptCount"`
+	SweptVolume float32 `json:"sweptVolume"`
+}
+
+// RichList defines model for RichList.
+type RichList struct {
+	Entries []RichListEntry `json:"entries"`
+
+	// NetworkLiquidity Unspent liquidity in BTC, including opted-out scripts
+	NetworkLiquidity float32 `json:"networkLiquidity"`
+	Timestamp        int64   `json:"timestamp"`
+}
+
+// RichListEntry defines model for RichListEntry.
+type RichListEntry struct {
+	// Balance Unspent balance in BTC
+	Balance float32 `json:"balance"`
+	Rank    int     `json:"rank"`
+	Script  string  `json:"script"`
+
+	// Share Percent of network liquidity
+	Share     float32 `json:"share"`
+	VtxoCount int     `json:"vtxoCount"`
+}
+
+// Round A commitment transaction; amounts are in sats
+type Round struct {
+	Asp string `json:"asp"`
+
+	// BoardingAmount Output value left after reissuing refreshed inputs, in excess of the other inputs
+	BoardingAmount int64 `json:"boardingAmount"`
+	CreatedAt      int64 `json:"createdAt"`
+
+	// ExitAmount Value of inputs that were not refreshed, in excess of the outputs left after reissuing refreshed ones
+	ExitAmount  int64 `json:"exitAmount"`
+	InputAmount int64 `json:"inputAmount"`
+
+	// InputCount VTXOs spent by the round, swept ones included
+	InputCount   int   `json:"inputCount"`
+	NewVtxoCount int   `json:"newVtxoCount"`
+	OutputAmount int64 `json:"outputAmount"`
+
+	// RefreshedAmount Value of inputs spent as a refresh, including swept ones
+	RefreshedAmount int64 `json:"refreshedAmount"`
+
+	// SweptAmount Part of refreshedAmount the ASP had swept
+	SweptAmount int64  `json:"sweptAmount"`
+	Txid        string `json:"txid"`
+}
+
+// RoundDetail defines model for RoundDetail.
+type RoundDetail struct {
+	Asp string `json:"asp"`
+
+	// BoardingAmount Output value left after reissuing refreshed inputs, in excess of the other inputs
+	BoardingAmount int64 `json:"boardingAmount"`
+	CreatedAt      int64 `json:"createdAt"`
+
+	// ExitAmount Value of inputs that were not refreshed, in excess of the outputs left after reissuing refreshed ones
+	ExitAmount  int64 `json:"exitAmount"`
+	InputAmount int64 `json:"inputAmount"`
+
+	// InputCount VTXOs spent by the round, swept ones included
+	InputCount   int    `json:"inputCount"`
+	Inputs       []VTXO `json:"inputs"`
+	NewVtxoCount int    `json:"newVtxoCount"`
+	OutputAmount int64  `json:"outputAmount"`
+	Outputs      []VTXO `json:"outputs"`
+
+	// RefreshedAmount Value of inputs spent as a refresh, including swept ones
+	RefreshedAmount int64 `json:"refreshedAmount"`
+
+	// SweptAmount Part of refreshedAmount the ASP had swept
+	SweptAmount int64  `json:"sweptAmount"`
+	Txid        string `json:"txid"`
+}
+
+// RoundNetFlow Forfeited inputs minus outputs of rounds. Boarding and exits settle on L1, which is not seen, so this is not a fee.
+type RoundNetFlow struct {
+	Count int `json:"count"`
+
+	// MeanNetFlowSats Sats per round
+	MeanNetFlowSats float32 `json:"meanNetFlowSats"`
+
+	// NetFlow BTC
+	NetFlow float32 `json:"netFlow"`
+
+	// Volume Output value in BTC
+	Volume float32 `json:"volume"`
+}
+
+// RoundPage defines model for RoundPage.
+type RoundPage struct {
+	NextCursor *string `json:"nextCursor"`
+	Rounds     []Round `json:"rounds"`
+}
+
+// RoundStats defines model for RoundStats.
+type RoundStats struct {
+	IntervalSeconds  Percentiles `json:"intervalSeconds"`
+	NewVtxosPerRound Percentiles `json:"newVtxosPerRound"`
+	Series           []struct {
+		BoardingVolume  float32 `json:"boardingVolume"`
+		DisplayDate     string  `json:"displayDate"`
+		ExitVolume      float32 `json:"exitVolume"`
+		NewVtxos        int     `json:"newVtxos"`
+		RefreshedVolume float32 `json:"refreshedVolume"`
+		Rounds          int     `json:"rounds"`
+	} `json:"series"`
+
+	// Summary Volumes in BTC
+	Summary struct {
+		BoardingVolume  float32 `json:"boardingVolume"`
+		Count           int     `json:"count"`
+		ExitVolume      float32 `json:"exitVolume"`
+		MeanInputs      float32 `json:"meanInputs"`
+		MeanNewVtxos    float32 `json:"meanNewVtxos"`
+		RefreshedVolume float32 `json:"refreshedVolume"`
+		SweptVolume     float32 `json:"sweptVolume"`
+	} `json:"summary"`
+	Timeframe string `json:"timeframe"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+	// ActiveScripts Distinct scripts that received or spent VTXOs in the timeframe
+	ActiveScripts int          `json:"activeScripts"`
+	Asps          []ASPMetrics `json:"asps"`
+
+	// Changes Keyed by stat field, e.g. networkLiquidity or activeScripts
+	Changes map[string]MetricChange `json:"changes"`
+	Compare StatsCompare            `json:"compare"`
+
+	// ComparedWith Comparison window in unix seconds; null for all time against the previous period
+	ComparedWith *struct {
+		From int64 `json:"from"`
+		To   int64 `json:"to"`
+	} `json:"comparedWith"`
+	NetworkLiquidity float32 `json:"networkLiquidity"`
+
+	// NewScripts Active scripts that received their first VTXO in the timeframe
+	NewScripts        int     `json:"newScripts"`
+	OffboardingVolume float32 `json:"offboardingVolume"`
+	OnboardingVolume  float32 `json:"onboardingVolume"`
+
+	// ReturningScripts Active scripts seen before the timeframe
+	ReturningScripts int    `json:"returningScripts"`
+	Timeframe        string `json:"timeframe"`
+	Timestamp        int64  `json:"timestamp"`
+
+	// TxCountChange Same as changes.virtualTxCount.percent
+	TxCountChange   *float32 `json:"txCountChange"`
+	VirtualTxCount  int      `json:"virtualTxCount"`
+	VirtualTxVolume float32  `json:"virtualTxVolume"`
+
+	// VolumeChange Same as changes.virtualTxVolume.percent
+	VolumeChange *float32 `json:"volumeChange"`
+}
+
+// StatsCompare defines model for Stats.Compare.
+type StatsCompare string
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// Amount Total of the VTXOs created, in sats
+	Amount    int64  `json:"amount"`
+	Asp       string `json:"asp"`
+	CreatedAt int64  `json:"createdAt"`
+
+	// InputAmount Total of the VTXOs spent, in sats
+	InputAmount int64 `json:"inputAmount"`
+
+	// InputCount VTXOs spent
+	InputCount int    `json:"inputCount"`
+	TxType     string `json:"txType"`
+	Txid       string `json:"txid"`
+
+	// VtxoCount VTXOs created
+	VtxoCount int `json:"vtxoCount"`
+}
+
+// TransactionPage defines model for TransactionPage.
+type TransactionPage struct {
+	NextCursor   *string       `json:"nextCursor"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// TrendPoint defines model for TrendPoint.
+type TrendPoint struct {
+	// ActiveScripts Distinct scripts that received or spent VTXOs in the bucket
+	ActiveScripts int     `json:"activeScripts"`
+	Asp           *string `json:"asp,omitempty"`
+	DisplayDate   string  `json:"displayDate"`
+
+	// NewScripts Active scripts whose first VTXO falls in the bucket
+	NewScripts        int     `json:"newScripts"`
+	OffboardingVolume float32 `json:"offboardingVolume"`
+	OnboardingVolume  float32 `json:"onboardingVolume"`
+	ReturningScripts  int     `json:"returningScripts"`
+	VirtualTxCount    int     `json:"virtualTxCount"`
+	VirtualTxVolume   float32 `json:"virtualTxVolume"`
+}
+
+// VTXO defines model for VTXO.
+type VTXO struct {
+	Amount    int64  `json:"amount"`
+	Asp       string `json:"asp"`
+	CreatedAt int64  `json:"createdAt"`
+	ExpiresAt int64  `json:"expiresAt"`
+	IsSpent   bool   `json:"isSpent"`
+	IsSwept   bool   `json:"isSwept"`
+	Script    string `json:"script"`
+
+	// SpentAt Unix seconds; 0 while unspent
+	SpentAt int64  `json:"spentAt"`
+	SpentBy string `json:"spentBy"`
+	TxType  string `json:"txType"`
+	Txid    string `json:"txid"`
+	Vout    int    `json:"vout"`
+}
+
+// Watch defines model for Watch.
+type Watch struct {
+	CallbackUrl string    `json:"callbackUrl"`
+	CreatedAt   int64     `json:"createdAt"`
+	ExpiryHours int       `json:"expiryHours"`
+	Id          int64     `json:"id"`
+	Kind        WatchKind `json:"kind"`
+	Script      *string   `json:"script,omitempty"`
+	Secret      *string   `json:"secret,omitempty"`
+	Target      string    `json:"target"`
+	Txid        *string   `json:"txid,omitempty"`
+	Vout        *int      `json:"vout,omitempty"`
+}
+
+// WatchKind defines model for Watch.Kind.
+type WatchKind string
+
+// WatchRequest Exactly one of address, script or outpoint
+type WatchRequest struct {
+	Address *string `json:"address,omitempty"`
+
+	// CallbackUrl Public http(s) URL; loopback, private and link-local addresses are refused
+	CallbackUrl string `json:"callbackUrl"`
+	ExpiryHours *int   `json:"expiryHours,omitempty"`
+
+	// Outpoint txid:vout
+	Outpoint *string `json:"outpoint,omitempty"`
+	Script   *string `json:"script,omitempty"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int                   `json:"attempts"`
+	CreatedAt     int64                 `json:"createdAt"`
+	DeliveredAt   *int64                `json:"deliveredAt,omitempty"`
+	Event         WebhookDeliveryEvent  `json:"event"`
+	Id            int64                 `json:"id"`
+	LastError     *string               `json:"lastError,omitempty"`
+	NextAttemptAt int64                 `json:"nextAttemptAt"`
+	Payload       string                `json:"payload"`
+	ResponseCode  *int                  `json:"responseCode,omitempty"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Txid          string                `json:"txid"`
+	Vout          int                   `json:"vout"`
+	WatchId       int64                 `json:"watchId"`
+}
+
+// WebhookDeliveryEvent defines model for WebhookDelivery.Event.
+type WebhookDeliveryEvent string
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// Asp defines model for Asp.
+type Asp = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// Timeframe defines model for Timeframe.
+type Timeframe string
+
+// WatchID defines model for WatchID.
+type WatchID = int64
+
+// WatchSecret defines model for WatchSecret.
+type WatchSecret = string
+
+// RateLimited defines model for RateLimited.
+type RateLimited = Error
+
+// GetAlertsParams defines parameters for GetAlerts.
+type GetAlertsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Metric Comma separated metrics
+	Metric *string `form:"metric,omitempty" json:"metric,omitempty"`
+
+	// Since Unix seconds, inclusive
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetAmountDistributionParams defines parameters for GetAmountDistribution.
+type GetAmountDistributionParams struct {
+	Timeframe *GetAmountDistributionParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetAmountDistributionParamsTimeframe defines parameters for GetAmountDistribution.
+type GetAmountDistributionParamsTimeframe string
+
+// GetConcentrationParams defines parameters for GetConcentration.
+type GetConcentrationParams struct {
+	Timeframe *GetConcentrationParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetConcentrationParamsTimeframe defines parameters for GetConcentration.
+type GetConcentrationParamsTimeframe string
+
+// GetFeeAnalyticsParams defines parameters for GetFeeAnalytics.
+type GetFeeAnalyticsParams struct {
+	Timeframe *GetFeeAnalyticsParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetFeeAnalyticsParamsTimeframe defines parameters for GetFeeAnalytics.
+type GetFeeAnalyticsParamsTimeframe string
+
+// GetFlowsParams defines parameters for GetFlows.
+type GetFlowsParams struct {
+	Timeframe *GetFlowsParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetFlowsParamsTimeframe defines parameters for GetFlows.
+type GetFlowsParamsTimeframe string
+
+// GetLifetimesParams defines parameters for GetLifetimes.
+type GetLifetimesParams struct {
+	Timeframe *GetLifetimesParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetLifetimesParamsTimeframe defines parameters for GetLifetimes.
+type GetLifetimesParamsTimeframe string
+
+// GetRefreshAnalyticsParams defines parameters for GetRefreshAnalytics.
+type GetRefreshAnalyticsParams struct {
+	Timeframe *GetRefreshAnalyticsParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetRefreshAnalyticsParamsTimeframe defines parameters for GetRefreshAnalytics.
+type GetRefreshAnalyticsParamsTimeframe string
+
+// GetRichListParams defines parameters for GetRichList.
+type GetRichListParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetRoundStatsParams defines parameters for GetRoundStats.
+type GetRoundStatsParams struct {
+	Timeframe *GetRoundStatsParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetRoundStatsParamsTimeframe defines parameters for GetRoundStats.
+type GetRoundStatsParamsTimeframe string
+
+// GetASPComparisonParams defines parameters for GetASPComparison.
+type GetASPComparisonParams struct {
+	Timeframe *GetASPComparisonParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+}
+
+// GetASPComparisonParamsTimeframe defines parameters for GetASPComparison.
+type GetASPComparisonParamsTimeframe string
+
+// GetExpiryCalendarParams defines parameters for GetExpiryCalendar.
+type GetExpiryCalendarParams struct {
+	Days   *int                           `form:"days,omitempty" json:"days,omitempty"`
+	Bucket *GetExpiryCalendarParamsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// Limit Number of at-risk VTXOs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetExpiryCalendarParamsBucket defines parameters for GetExpiryCalendar.
+type GetExpiryCalendarParamsBucket string
+
+// ExportDatasetParams defines parameters for ExportDataset.
+type ExportDatasetParams struct {
+	Format *ExportDatasetParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Unix seconds, inclusive
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Unix seconds, exclusive
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+
+	// Type Comma separated tx types (vtxos only)
+	Type      *string                       `form:"type,omitempty" json:"type,omitempty"`
+	Timeframe *ExportDatasetParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// ExportDatasetParamsFormat defines parameters for ExportDataset.
+type ExportDatasetParamsFormat string
+
+// ExportDatasetParamsTimeframe defines parameters for ExportDataset.
+type ExportDatasetParamsTimeframe string
+
+// ExportDatasetParamsDataset defines parameters for ExportDataset.
+type ExportDatasetParamsDataset string
+
+// GetLiveParams defines parameters for GetLive.
+type GetLiveParams struct {
+	// Type Comma separated tx types
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Address Comma separated Ark addresses
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// Script Comma separated hex scripts
+	Script *string `form:"script,omitempty" json:"script,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetRecentTransactionsParams defines parameters for GetRecentTransactions.
+type GetRecentTransactionsParams struct {
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetRoundsParams defines parameters for GetRounds.
+type GetRoundsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// From Unix seconds, inclusive
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Unix seconds, exclusive
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	Timeframe *GetStatsParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+
+	// Compare Compare with the period just before (previous), or the same period a week or month earlier
+	Compare *GetStatsParamsCompare `form:"compare,omitempty" json:"compare,omitempty"`
+
+	// IfNoneMatch ETag of a previous response
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetStatsParamsTimeframe defines parameters for GetStats.
+type GetStatsParamsTimeframe string
+
+// GetStatsParamsCompare defines parameters for GetStats.
+type GetStatsParamsCompare string
+
+// GetTransactionsParams defines parameters for GetTransactions.
+type GetTransactionsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Type Comma separated tx types
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// MinAmount Minimum of the larger of output and input totals, in sats
+	MinAmount *int64 `form:"minAmount,omitempty" json:"minAmount,omitempty"`
+
+	// MaxAmount Maximum of the larger of output and input totals, in sats
+	MaxAmount *int64 `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
+
+	// From Unix seconds, inclusive
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Unix seconds, exclusive
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// GetTrendsParams defines parameters for GetTrends.
+type GetTrendsParams struct {
+	Timeframe *GetTrendsParamsTimeframe `form:"timeframe,omitempty" json:"timeframe,omitempty"`
+
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+
+	// Breakdown Set to `asp` for one point per ASP and bucket
+	Breakdown *GetTrendsParamsBreakdown `form:"breakdown,omitempty" json:"breakdown,omitempty"`
+
+	// IfNoneMatch ETag of a previous response
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetTrendsParamsTimeframe defines parameters for GetTrends.
+type GetTrendsParamsTimeframe string
+
+// GetTrendsParamsBreakdown defines parameters for GetTrends.
+type GetTrendsParamsBreakdown string
+
+// GetTransactionParams defines parameters for GetTransaction.
+type GetTransactionParams struct {
+	// Asp Restrict results to one ASP
+	Asp *Asp `form:"asp,omitempty" json:"asp,omitempty"`
+}
+
+// DeleteWatchParams defines parameters for DeleteWatch.
+type DeleteWatchParams struct {
+	XWatchSecret WatchSecret `json:"X-Watch-Secret"`
+}
+
+// GetWatchDeliveriesParams defines parameters for GetWatchDeliveries.
+type GetWatchDeliveriesParams struct {
+	XWatchSecret WatchSecret `json:"X-Watch-Secret"`
+}
+
+// CreateWatchJSONRequestBody defines body for CreateWatch for application/json ContentType.
+type CreateWatchJSONRequestBody = WatchRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAlerts request
+	GetAlerts(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAmountDistribution request
+	GetAmountDistribution(ctx context.Context, params *GetAmountDistributionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConcentration request
+	GetConcentration(ctx context.Context, params *GetConcentrationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFeeAnalytics request
+	GetFeeAnalytics(ctx context.Context, params *GetFeeAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFlows request
+	GetFlows(ctx context.Context, params *GetFlowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLifetimes request
+	GetLifetimes(ctx context.Context, params *GetLifetimesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRefreshAnalytics request
+	GetRefreshAnalytics(ctx context.Context, params *GetRefreshAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRichList request
+	GetRichList(ctx context.Context, params *GetRichListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoundStats request
+	GetRoundStats(ctx context.Context, params *GetRoundStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetASPComparison request
+	GetASPComparison(ctx context.Context, params *GetASPComparisonParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExpiryCalendar request
+	GetExpiryCalendar(ctx context.Context, params *GetExpiryCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportDataset request
+	ExportDataset(ctx context.Context, dataset ExportDatasetParamsDataset, params *ExportDatasetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLive request
+	GetLive(ctx context.Context, params *GetLiveParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPISpec request
+	GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecentTransactions request
+	GetRecentTransactions(ctx context.Context, params *GetRecentTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRounds request
+	GetRounds(ctx context.Context, params *GetRoundsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRound request
+	GetRound(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStats request
+	GetStats(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransactions request
+	GetTransactions(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrends request
+	GetTrends(ctx context.Context, params *GetTrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransaction request
+	GetTransaction(ctx context.Context, txid string, params *GetTransactionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWatchWithBody request with any body
+	CreateWatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWatch(ctx context.Context, body CreateWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWatch request
+	DeleteWatch(ctx context.Context, id WatchID, params *DeleteWatchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWatchDeliveries request
+	GetWatchDeliveries(ctx context.Context, id WatchID, params *GetWatchDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Healthz request
+	Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAlerts(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAmountDistribution(ctx context.Context, params *GetAmountDistributionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAmountDistributionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConcentration(ctx context.Context, params *GetConcentrationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConcentrationRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFeeAnalytics(ctx context.Context, params *GetFeeAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFeeAnalyticsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFlows(ctx context.Context, params *GetFlowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFlowsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLifetimes(ctx context.Context, params *GetLifetimesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLifetimesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRefreshAnalytics(ctx context.Context, params *GetRefreshAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRefreshAnalyticsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRichList(ctx context.Context, params *GetRichListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRichListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRoundStats(ctx context.Context, params *GetRoundStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoundStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetASPComparison(ctx context.Context, params *GetASPComparisonParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetASPComparisonRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetExpiryCalendar(ctx context.Context, params *GetExpiryCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExpiryCalendarRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportDataset(ctx context.Context, dataset ExportDatasetParamsDataset, params *ExportDatasetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDatasetRequest(c.Server, dataset, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLive(ctx context.Context, params *GetLiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLiveRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPISpecRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRecentTransactions(ctx context.Context, params *GetRecentTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecentTransactionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRounds(ctx context.Context, params *GetRoundsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoundsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRound(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoundRequest(c.Server, txid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStats(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransactions(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTrends(ctx context.Context, params *GetTrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrendsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransaction(ctx context.Context, txid string, params *GetTransactionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionRequest(c.Server, txid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWatch(ctx context.Context, body CreateWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWatch(ctx context.Context, id WatchID, params *DeleteWatchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWatchRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWatchDeliveries(ctx context.Context, id WatchID, params *GetWatchDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWatchDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAlertsRequest generates requests for GetAlerts
+func NewGetAlertsRequest(server string, params *GetAlertsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/alerts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Metric != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "metric", runtime.ParamLocationQuery, *params.Metric); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAmountDistributionRequest generates requests for GetAmountDistribution
+func NewGetAmountDistributionRequest(server string, params *GetAmountDistributionParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/amounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetConcentrationRequest generates requests for GetConcentration
+func NewGetConcentrationRequest(server string, params *GetConcentrationParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/concentration")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFeeAnalyticsRequest generates requests for GetFeeAnalytics
+func NewGetFeeAnalyticsRequest(server string, params *GetFeeAnalyticsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/fees")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFlowsRequest generates requests for GetFlows
+func NewGetFlowsRequest(server string, params *GetFlowsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/flows")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLifetimesRequest generates requests for GetLifetimes
+func NewGetLifetimesRequest(server string, params *GetLifetimesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/lifetimes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRefreshAnalyticsRequest generates requests for GetRefreshAnalytics
+func NewGetRefreshAnalyticsRequest(server string, params *GetRefreshAnalyticsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRichListRequest generates requests for GetRichList
+func NewGetRichListRequest(server string, params *GetRichListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/rich-list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRoundStatsRequest generates requests for GetRoundStats
+func NewGetRoundStatsRequest(server string, params *GetRoundStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/analytics/rounds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetASPComparisonRequest generates requests for GetASPComparison
+func NewGetASPComparisonRequest(server string, params *GetASPComparisonParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/asps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExpiryCalendarRequest generates requests for GetExpiryCalendar
+func NewGetExpiryCalendarRequest(server string, params *GetExpiryCalendarParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/expiry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Days != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportDatasetRequest generates requests for ExportDataset
+func NewExportDatasetRequest(server string, dataset ExportDatasetParamsDataset, params *ExportDatasetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dataset", runtime.ParamLocationPath, dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/export/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLiveRequest generates requests for GetLive
+func NewGetLiveRequest(server string, params *GetLiveParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Address != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "address", runtime.ParamLocationQuery, *params.Address); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Script != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "script", runtime.ParamLocationQuery, *params.Script); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPISpecRequest generates requests for GetOpenAPISpec
+func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/openapi.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRecentTransactionsRequest generates requests for GetRecentTransactions
+func NewGetRecentTransactionsRequest(server string, params *GetRecentTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/recent-transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRoundsRequest generates requests for GetRounds
+func NewGetRoundsRequest(server string, params *GetRoundsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rounds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRoundRequest generates requests for GetRound
+func NewGetRoundRequest(server string, txid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rounds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsRequest generates requests for GetStats
+func NewGetStatsRequest(server string, params *GetStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Compare != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "compare", runtime.ParamLocationQuery, *params.Compare); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionsRequest generates requests for GetTransactions
+func NewGetTransactionsRequest(server string, params *GetTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinAmount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minAmount", runtime.ParamLocationQuery, *params.MinAmount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxAmount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxAmount", runtime.ParamLocationQuery, *params.MaxAmount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrendsRequest generates requests for GetTrends
+func NewGetTrendsRequest(server string, params *GetTrendsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/trends")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timeframe != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeframe", runtime.ParamLocationQuery, *params.Timeframe); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Breakdown != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "breakdown", runtime.ParamLocationQuery, *params.Breakdown); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionRequest generates requests for GetTransaction
+func NewGetTransactionRequest(server string, txid string, params *GetTransactionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tx/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Asp != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "asp", runtime.ParamLocationQuery, *params.Asp); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWatchRequest calls the generic CreateWatch builder with application/json body
+func NewCreateWatchRequest(server string, body CreateWatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWatchRequestWithBody generates requests for CreateWatch with any type of body
+func NewCreateWatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/watches")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWatchRequest generates requests for DeleteWatch
+func NewDeleteWatchRequest(server string, id WatchID, params *DeleteWatchParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/watches/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Watch-Secret", runtime.ParamLocationHeader, params.XWatchSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Watch-Secret", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetWatchDeliveriesRequest generates requests for GetWatchDeliveries
+func NewGetWatchDeliveriesRequest(server string, id WatchID, params *GetWatchDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/watches/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Watch-Secret", runtime.ParamLocationHeader, params.XWatchSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Watch-Secret", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAlertsWithResponse request
+	GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error)
+
+	// GetAmountDistributionWithResponse request
+	GetAmountDistributionWithResponse(ctx context.Context, params *GetAmountDistributionParams, reqEditors ...RequestEditorFn) (*GetAmountDistributionResponse, error)
+
+	// GetConcentrationWithResponse request
+	GetConcentrationWithResponse(ctx context.Context, params *GetConcentrationParams, reqEditors ...RequestEditorFn) (*GetConcentrationResponse, error)
+
+	// GetFeeAnalyticsWithResponse request
+	GetFeeAnalyticsWithResponse(ctx context.Context, params *GetFeeAnalyticsParams, reqEditors ...RequestEditorFn) (*GetFeeAnalyticsResponse, error)
+
+	// GetFlowsWithResponse request
+	GetFlowsWithResponse(ctx context.Context, params *GetFlowsParams, reqEditors ...RequestEditorFn) (*GetFlowsResponse, error)
+
+	// GetLifetimesWithResponse request
+	GetLifetimesWithResponse(ctx context.Context, params *GetLifetimesParams, reqEditors ...RequestEditorFn) (*GetLifetimesResponse, error)
+
+	// GetRefreshAnalyticsWithResponse request
+	GetRefreshAnalyticsWithResponse(ctx context.Context, params *GetRefreshAnalyticsParams, reqEditors ...RequestEditorFn) (*GetRefreshAnalyticsResponse, error)
+
+	// GetRichListWithResponse request
+	GetRichListWithResponse(ctx context.Context, params *GetRichListParams, reqEditors ...RequestEditorFn) (*GetRichListResponse, error)
+
+	// GetRoundStatsWithResponse request
+	GetRoundStatsWithResponse(ctx context.Context, params *GetRoundStatsParams, reqEditors ...RequestEditorFn) (*GetRoundStatsResponse, error)
+
+	// GetASPComparisonWithResponse request
+	GetASPComparisonWithResponse(ctx context.Context, params *GetASPComparisonParams, reqEditors ...RequestEditorFn) (*GetASPComparisonResponse, error)
+
+	// GetExpiryCalendarWithResponse request
+	GetExpiryCalendarWithResponse(ctx context.Context, params *GetExpiryCalendarParams, reqEditors ...RequestEditorFn) (*GetExpiryCalendarResponse, error)
+
+	// ExportDatasetWithResponse request
+	ExportDatasetWithResponse(ctx context.Context, dataset ExportDatasetParamsDataset, params *ExportDatasetParams, reqEditors ...RequestEditorFn) (*ExportDatasetResponse, error)
+
+	// GetLiveWithResponse request
+	GetLiveWithResponse(ctx context.Context, params *GetLiveParams, reqEditors ...RequestEditorFn) (*GetLiveResponse, error)
+
+	// GetOpenAPISpecWithResponse request
+	GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error)
+
+	// GetRecentTransactionsWithResponse request
+	GetRecentTransactionsWithResponse(ctx context.Context, params *GetRecentTransactionsParams, reqEditors ...RequestEditorFn) (*GetRecentTransactionsResponse, error)
+
+	// GetRoundsWithResponse request
+	GetRoundsWithResponse(ctx context.Context, params *GetRoundsParams, reqEditors ...RequestEditorFn) (*GetRoundsResponse, error)
+
+	// GetRoundWithResponse request
+	GetRoundWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GetRoundResponse, error)
+
+	// GetStatsWithResponse request
+	GetStatsWithResponse(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*GetStatsResponse, error)
+
+	// GetTransactionsWithResponse request
+	GetTransactionsWithResponse(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error)
+
+	// GetTrendsWithResponse request
+	GetTrendsWithResponse(ctx context.Context, params *GetTrendsParams, reqEditors ...RequestEditorFn) (*GetTrendsResponse, error)
+
+	// GetTransactionWithResponse request
+	GetTransactionWithResponse(ctx context.Context, txid string, params *GetTransactionParams, reqEditors ...RequestEditorFn) (*GetTransactionResponse, error)
+
+	// CreateWatchWithBodyWithResponse request with any body
+	CreateWatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWatchResponse, error)
+
+	CreateWatchWithResponse(ctx context.Context, body CreateWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWatchResponse, error)
+
+	// DeleteWatchWithResponse request
+	DeleteWatchWithResponse(ctx context.Context, id WatchID, params *DeleteWatchParams, reqEditors ...RequestEditorFn) (*DeleteWatchResponse, error)
+
+	// GetWatchDeliveriesWithResponse request
+	GetWatchDeliveriesWithResponse(ctx context.Context, id WatchID, params *GetWatchDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWatchDeliveriesResponse, error)
+
+	// HealthzWithResponse request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+
+	// ReadyzWithResponse request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error)
+}
+
+type GetAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Alert
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAmountDistributionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AmountDistribution
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAmountDistributionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAmountDistributionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConcentrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Concentration
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConcentrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConcentrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeeAnalyticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeeAnalytics
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeeAnalyticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeeAnalyticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFlowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Flows
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFlowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFlowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLifetimesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Lifetimes
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLifetimesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLifetimesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRefreshAnalyticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RefreshAnalytics
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRefreshAnalyticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRefreshAnalyticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRichListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RichList
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRichListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRichListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoundStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoundStats
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoundStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoundStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetASPComparisonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ASPComparison
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetASPComparisonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetASPComparisonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExpiryCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExpiryCalendar
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExpiryCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExpiryCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDatasetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON429      *RateLimited
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDatasetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDatasetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON429      *RateLimited
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPISpecResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *string
+	JSON429      *RateLimited
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPISpecResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPISpecResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecentTransactionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RecentTransaction
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecentTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecentTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoundsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoundPage
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoundsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoundsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoundDetail
+	JSON404      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionPage
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrendsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TrendPoint
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrendsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrendsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VTXO
+	JSON400      *Error
+	JSON404      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Watch
+	JSON400      *Error
+	JSON429      *RateLimited
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON429      *RateLimited
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWatchDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDelivery
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON429      *RateLimited
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWatchDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWatchDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status string `json:"status"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Readiness
+	JSON503      *Readiness
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAlertsWithResponse request returning *GetAlertsResponse
+func (c *ClientWithResponses) GetAlertsWithResponse(ctx context.Context, params *GetAlertsParams, reqEditors ...RequestEditorFn) (*GetAlertsResponse, error) {
+	rsp, err := c.GetAlerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertsResponse(rsp)
+}
+
+// GetAmountDistributionWithResponse request returning *GetAmountDistributionResponse
+func (c *ClientWithResponses) GetAmountDistributionWithResponse(ctx context.Context, params *GetAmountDistributionParams, reqEditors ...RequestEditorFn) (*GetAmountDistributionResponse, error) {
+	rsp, err := c.GetAmountDistribution(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAmountDistributionResponse(rsp)
+}
+
+// GetConcentrationWithResponse request returning *GetConcentrationResponse
+func (c *ClientWithResponses) GetConcentrationWithResponse(ctx context.Context, params *GetConcentrationParams, reqEditors ...RequestEditorFn) (*GetConcentrationResponse, error) {
+	rsp, err := c.GetConcentration(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConcentrationResponse(rsp)
+}
+
+// GetFeeAnalyticsWithResponse request returning *GetFeeAnalyticsResponse
+func (c *ClientWithResponses) GetFeeAnalyticsWithResponse(ctx context.Context, params *GetFeeAnalyticsParams, reqEditors ...RequestEditorFn) (*GetFeeAnalyticsResponse, error) {
+	rsp, err := c.GetFeeAnalytics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeeAnalyticsResponse(rsp)
+}
+
+// GetFlowsWithResponse request returning *GetFlowsResponse
+func (c *ClientWithResponses) GetFlowsWithResponse(ctx context.Context, params *GetFlowsParams, reqEditors ...RequestEditorFn) (*GetFlowsResponse, error) {
+	rsp, err := c.GetFlows(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFlowsResponse(rsp)
+}
+
+// GetLifetimesWithResponse request returning *GetLifetimesResponse
+func (c *ClientWithResponses) GetLifetimesWithResponse(ctx context.Context, params *GetLifetimesParams, reqEditors ...RequestEditorFn) (*GetLifetimesResponse, error) {
+	rsp, err := c.GetLifetimes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLifetimesResponse(rsp)
+}
+
+// GetRefreshAnalyticsWithResponse request returning *GetRefreshAnalyticsResponse
+func (c *ClientWithResponses) GetRefreshAnalyticsWithResponse(ctx context.Context, params *GetRefreshAnalyticsParams, reqEditors ...RequestEditorFn) (*GetRefreshAnalyticsResponse, error) {
+	rsp, err := c.GetRefreshAnalytics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRefreshAnalyticsResponse(rsp)
+}
+
+// GetRichListWithResponse request returning *GetRichListResponse
+func (c *ClientWithResponses) GetRichListWithResponse(ctx context.Context, params *GetRichListParams, reqEditors ...RequestEditorFn) (*GetRichListResponse, error) {
+	rsp, err := c.GetRichList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRichListResponse(rsp)
+}
+
+// GetRoundStatsWithResponse request returning *GetRoundStatsResponse
+func (c *ClientWithResponses) GetRoundStatsWithResponse(ctx context.Context, params *GetRoundStatsParams, reqEditors ...RequestEditorFn) (*GetRoundStatsResponse, error) {
+	rsp, err := c.GetRoundStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoundStatsResponse(rsp)
+}
+
+// GetASPComparisonWithResponse request returning *GetASPComparisonResponse
+func (c *ClientWithResponses) GetASPComparisonWithResponse(ctx context.Context, params *GetASPComparisonParams, reqEditors ...RequestEditorFn) (*GetASPComparisonResponse, error) {
+	rsp, err := c.GetASPComparison(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetASPComparisonResponse(rsp)
+}
+
+// GetExpiryCalendarWithResponse request returning *GetExpiryCalendarResponse
+func (c *ClientWithResponses) GetExpiryCalendarWithResponse(ctx context.Context, params *GetExpiryCalendarParams, reqEditors ...RequestEditorFn) (*GetExpiryCalendarResponse, error) {
+	rsp, err := c.GetExpiryCalendar(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExpiryCalendarResponse(rsp)
+}
+
+// ExportDatasetWithResponse request returning *ExportDatasetResponse
+func (c *ClientWithResponses) ExportDatasetWithResponse(ctx context.Context, dataset ExportDatasetParamsDataset, params *ExportDatasetParams, reqEditors ...RequestEditorFn) (*ExportDatasetResponse, error) {
+	rsp, err := c.ExportDataset(ctx, dataset, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDatasetResponse(rsp)
+}
+
+// GetLiveWithResponse request returning *GetLiveResponse
+func (c *ClientWithResponses) GetLiveWithResponse(ctx context.Context, params *GetLiveParams, reqEditors ...RequestEditorFn) (*GetLiveResponse, error) {
+	rsp, err := c.GetLive(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLiveResponse(rsp)
+}
+
+// GetOpenAPISpecWithResponse request returning *GetOpenAPISpecResponse
+func (c *ClientWithResponses) GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error) {
+	rsp, err := c.GetOpenAPISpec(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPISpecResponse(rsp)
+}
+
+// GetRecentTransactionsWithResponse request returning *GetRecentTransactionsResponse
+func (c *ClientWithResponses) GetRecentTransactionsWithResponse(ctx context.Context, params *GetRecentTransactionsParams, reqEditors ...RequestEditorFn) (*GetRecentTransactionsResponse, error) {
+	rsp, err := c.GetRecentTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecentTransactionsResponse(rsp)
+}
+
+// GetRoundsWithResponse request returning *GetRoundsResponse
+func (c *ClientWithResponses) GetRoundsWithResponse(ctx context.Context, params *GetRoundsParams, reqEditors ...RequestEditorFn) (*GetRoundsResponse, error) {
+	rsp, err := c.GetRounds(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoundsResponse(rsp)
+}
+
+// GetRoundWithResponse request returning *GetRoundResponse
+func (c *ClientWithResponses) GetRoundWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GetRoundResponse, error) {
+	rsp, err := c.GetRound(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoundResponse(rsp)
+}
+
+// GetStatsWithResponse request returning *GetStatsResponse
+func (c *ClientWithResponses) GetStatsWithResponse(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*GetStatsResponse, error) {
+	rsp, err := c.GetStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsResponse(rsp)
+}
+
+// GetTransactionsWithResponse request returning *GetTransactionsResponse
+func (c *ClientWithResponses) GetTransactionsWithResponse(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error) {
+	rsp, err := c.GetTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionsResponse(rsp)
+}
+
+// GetTrendsWithResponse request returning *GetTrendsResponse
+func (c *ClientWithResponses) GetTrendsWithResponse(ctx context.Context, params *GetTrendsParams, reqEditors ...RequestEditorFn) (*GetTrendsResponse, error) {
+	rsp, err := c.GetTrends(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrendsResponse(rsp)
+}
+
+// GetTransactionWithResponse request returning *GetTransactionResponse
+func (c *ClientWithResponses) GetTransactionWithResponse(ctx context.Context, txid string, params *GetTransactionParams, reqEditors ...RequestEditorFn) (*GetTransactionResponse, error) {
+	rsp, err := c.GetTransaction(ctx, txid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionResponse(rsp)
+}
+
+// CreateWatchWithBodyWithResponse request with arbitrary body returning *CreateWatchResponse
+func (c *ClientWithResponses) CreateWatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWatchResponse, error) {
+	rsp, err := c.CreateWatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWatchWithResponse(ctx context.Context, body CreateWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWatchResponse, error) {
+	rsp, err := c.CreateWatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWatchResponse(rsp)
+}
+
+// DeleteWatchWithResponse request returning *DeleteWatchResponse
+func (c *ClientWithResponses) DeleteWatchWithResponse(ctx context.Context, id WatchID, params *DeleteWatchParams, reqEditors ...RequestEditorFn) (*DeleteWatchResponse, error) {
+	rsp, err := c.DeleteWatch(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWatchResponse(rsp)
+}
+
+// GetWatchDeliveriesWithResponse request returning *GetWatchDeliveriesResponse
+func (c *ClientWithResponses) GetWatchDeliveriesWithResponse(ctx context.Context, id WatchID, params *GetWatchDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWatchDeliveriesResponse, error) {
+	rsp, err := c.GetWatchDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWatchDeliveriesResponse(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResponse
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResponse(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResponse
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResponse(rsp)
+}
+
+// ParseGetAlertsResponse parses an HTTP response from a GetAlertsWithResponse call
+func ParseGetAlertsResponse(rsp *http.Response) (*GetAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAmountDistributionResponse parses an HTTP response from a GetAmountDistributionWithResponse call
+func ParseGetAmountDistributionResponse(rsp *http.Response) (*GetAmountDistributionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAmountDistributionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AmountDistribution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConcentrationResponse parses an HTTP response from a GetConcentrationWithResponse call
+func ParseGetConcentrationResponse(rsp *http.Response) (*GetConcentrationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConcentrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Concentration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFeeAnalyticsResponse parses an HTTP response from a GetFeeAnalyticsWithResponse call
+func ParseGetFeeAnalyticsResponse(rsp *http.Response) (*GetFeeAnalyticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFeeAnalyticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeeAnalytics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFlowsResponse parses an HTTP response from a GetFlowsWithResponse call
+func ParseGetFlowsResponse(rsp *http.Response) (*GetFlowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFlowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Flows
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLifetimesResponse parses an HTTP response from a GetLifetimesWithResponse call
+func ParseGetLifetimesResponse(rsp *http.Response) (*GetLifetimesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLifetimesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Lifetimes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRefreshAnalyticsResponse parses an HTTP response from a GetRefreshAnalyticsWithResponse call
+func ParseGetRefreshAnalyticsResponse(rsp *http.Response) (*GetRefreshAnalyticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRefreshAnalyticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshAnalytics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRichListResponse parses an HTTP response from a GetRichListWithResponse call
+func ParseGetRichListResponse(rsp *http.Response) (*GetRichListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRichListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RichList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoundStatsResponse parses an HTTP response from a GetRoundStatsWithResponse call
+func ParseGetRoundStatsResponse(rsp *http.Response) (*GetRoundStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoundStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoundStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetASPComparisonResponse parses an HTTP response from a GetASPComparisonWithResponse call
+func ParseGetASPComparisonResponse(rsp *http.Response) (*GetASPComparisonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetASPComparisonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ASPComparison
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExpiryCalendarResponse parses an HTTP response from a GetExpiryCalendarWithResponse call
+func ParseGetExpiryCalendarResponse(rsp *http.Response) (*GetExpiryCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExpiryCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExpiryCalendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportDatasetResponse parses an HTTP response from a ExportDatasetWithResponse call
+func ParseExportDatasetResponse(rsp *http.Response) (*ExportDatasetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportDatasetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLiveResponse parses an HTTP response from a GetLiveWithResponse call
+func ParseGetLiveResponse(rsp *http.Response) (*GetLiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPISpecResponse parses an HTTP response from a GetOpenAPISpecWithResponse call
+func ParseGetOpenAPISpecResponse(rsp *http.Response) (*GetOpenAPISpecResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPISpecResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecentTransactionsResponse parses an HTTP response from a GetRecentTransactionsWithResponse call
+func ParseGetRecentTransactionsResponse(rsp *http.Response) (*GetRecentTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecentTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RecentTransaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoundsResponse parses an HTTP response from a GetRoundsWithResponse call
+func ParseGetRoundsResponse(rsp *http.Response) (*GetRoundsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoundsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoundPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoundResponse parses an HTTP response from a GetRoundWithResponse call
+func ParseGetRoundResponse(rsp *http.Response) (*GetRoundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoundDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsResponse parses an HTTP response from a GetStatsWithResponse call
+func ParseGetStatsResponse(rsp *http.Response) (*GetStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionsResponse parses an HTTP response from a GetTransactionsWithResponse call
+func ParseGetTransactionsResponse(rsp *http.Response) (*GetTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrendsResponse parses an HTTP response from a GetTrendsWithResponse call
+func ParseGetTrendsResponse(rsp *http.Response) (*GetTrendsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrendsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TrendPoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionResponse parses an HTTP response from a GetTransactionWithResponse call
+func ParseGetTransactionResponse(rsp *http.Response) (*GetTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VTXO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWatchResponse parses an HTTP response from a CreateWatchWithResponse call
+func ParseCreateWatchResponse(rsp *http.Response) (*CreateWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Watch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWatchResponse parses an HTTP response from a DeleteWatchWithResponse call
+func ParseDeleteWatchResponse(rsp *http.Response) (*DeleteWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWatchDeliveriesResponse parses an HTTP response from a GetWatchDeliveriesWithResponse call
+func ParseGetWatchDeliveriesResponse(rsp *http.Response) (*GetWatchDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWatchDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadyzResponse parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResponse(rsp *http.Response) (*ReadyzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
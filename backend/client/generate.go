@@ -0,0 +1,5 @@
+// Package client is a typed Go client for the Ark Explorer API, generated from
+// ../openapi.yaml. Run `go generate ./client` after changing the spec.
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -generate types,client -package client -o client.gen.go ../openapi.yaml
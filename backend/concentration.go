@@ -0,0 +1,289 @@
+package main
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "flag"
+    "fmt"
+    "log"
+    "net/http"
+    "os"
+    "strconv"
+    "strings"
+    "text/tabwriter"
+    "time"
+)
+
+// concentration accumulates script balances, largest first. For Gini over
+// ascending order, sum((n-i+1) * x_i) = (n+1) * sum(x) - sum(i * x_i) with
+// i the descending rank.
+type concentration struct {
+    n, total, top10, top100 int64
+    rankWeighted            float64
+}
+
+func (c *concentration) add(balance int64) {
+    c.n++
+    c.total += balance
+    c.rankWeighted += float64(c.n) * float64(balance)
+    if c.n <= 10 {
+        c.top10 += balance
+    }
+    if c.n <= 100 {
+        c.top100 += balance
+    }
+}
+
+func (c *concentration) stats(asp string) *ConcentrationStats {
+    stats := &ConcentrationStats{
+        Timestamp: time.Now().UnixMilli(),
+        Asp:       asp,
+        Holders:   int(c.n),
+        Liquidity: c.total,
+    }
+    if c.total > 0 {
+        sum := float64(c.total)
+        ascWeighted := float64(c.n+1)*sum - c.rankWeighted
+        stats.Gini = 2*ascWeighted/(float64(c.n)*sum) - float64(c.n+1)/float64(c.n)
+        stats.TopTenShare = float64(c.top10) / sum * 100
+        stats.TopHundredShare = float64(c.top100) / sum * 100
+    }
+    return stats
+}
+
+// computeConcentration groups unspent VTXOs of asp (all ASPs when empty) by
+// script and measures how evenly liquidity is spread across them.
+func computeConcentration(ctx context.Context, asp string) (*ConcentrationStats, error) {
+    q := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("script").
+        ColumnExpr("SUM(amount) AS total").
+        Where("is_spent = ?", false).
+        Group("script").
+        Order("total DESC")
+
+    var c concentration
+    err := iterateRows(ctx, q, func(row *struct {
+        Script string `bun:"script"`
+        Total  int64  `bun:"total"`
+    }) error {
+        c.add(row.Total)
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return c.stats(asp), nil
+}
+
+// updateConcentrationStats stores a snapshot for the network and each ASP.
+func updateConcentrationStats(ctx context.Context) {
+    for _, asp := range append([]string{""}, knownASPs(ctx)...) {
+        stats, err := computeConcentration(ctx, asp)
+        if err != nil {
+            log.Printf("Error computing concentration for %q: %v", asp, err)
+            continue
+        }
+        if _, err := DB.NewInsert().Model(stats).Exec(ctx); err != nil {
+            log.Printf("Error storing concentration for %q: %v", asp, err)
+        }
+    }
+}
+
+// StartConcentrationUpdater snapshots concentration at startup and hourly.
+// Grouping every unspent VTXO by script is too heavy for the minutely stats
+// updater.
+func StartConcentrationUpdater(ctx context.Context) {
+    ticker := time.NewTicker(time.Hour)
+    defer ticker.Stop()
+    updateConcentrationStats(ctx)
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            updateConcentrationStats(ctx)
+        }
+    }
+}
+
+// GetConcentration returns the latest liquidity concentration snapshot and
+// the stored hourly series for the timeframe.
+func GetConcentration(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    asp := r.URL.Query().Get("asp")
+
+    // Serve the latest hourly snapshot; compute only before the first one
+    current := new(ConcentrationStats)
+    err = DB.NewSelect().Model(current).Where("asp = ?", asp).Order("timestamp DESC").Limit(1).Scan(ctx)
+    if errors.Is(err, sql.ErrNoRows) {
+        current, err = computeConcentration(ctx, asp)
+    }
+    if err != nil {
+        return err
+    }
+
+    series := make([]ConcentrationStats, 0)
+    q := DB.NewSelect().Model(&series).Where("asp = ?", asp).Order("timestamp ASC")
+    if window := timeframeSeconds(timeframe); window > 0 {
+        q = q.Where("timestamp >= ?", (time.Now().Unix()-window)*1000)
+    }
+    if err := q.Scan(ctx); err != nil {
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "current":   current,
+        "series":    series,
+    })
+}
+
+// GetRichList returns the scripts holding the most unspent liquidity,
+// leaving out scripts that opted out.
+func GetRichList(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    limit := 100
+    if v := r.URL.Query().Get("limit"); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n <= 0 || n > 500 {
+            return badRequest("limit must be between 1 and 500")
+        }
+        limit = n
+    }
+
+    var liquidity int64
+    err := filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).
+        ColumnExpr("COALESCE(SUM(amount), 0)").
+        Where("is_spent = ?", false).
+        Scan(ctx, &liquidity)
+    if err != nil {
+        return err
+    }
+
+    type RichListEntry struct {
+        Rank      int     `bun:"-" json:"rank"`
+        Script    string  `bun:"script" json:"script"`
+        Balance   int64   `bun:"balance" json:"-"`
+        BTC       float64 `bun:"-" json:"balance"`
+        VtxoCount int     `bun:"vtxo_count" json:"vtxoCount"`
+        Share     float64 `bun:"-" json:"share"`
+    }
+    entries := make([]RichListEntry, 0)
+    err = filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).
+        ColumnExpr("script").
+        ColumnExpr("SUM(amount) AS balance").
+        ColumnExpr("COUNT(*) AS vtxo_count").
+        Where("is_spent = ?", false).
+        Where("script NOT IN (?)", DB.NewSelect().Model((*RichListOptOut)(nil)).Column("script")).
+        Group("script").
+        Order("balance DESC").
+        Limit(limit).
+        Scan(ctx, &entries)
+    if err != nil {
+        return err
+    }
+
+    for i := range entries {
+        e := &entries[i]
+        e.Rank = i + 1
+        e.BTC = float64(e.Balance) / 100000000.0
+        if liquidity > 0 {
+            e.Share = float64(e.Balance) / float64(liquidity) * 100
+        }
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "entries":          entries,
+        "networkLiquidity": float64(liquidity) / 100000000.0,
+        "timestamp":        time.Now().UnixMilli(),
+    })
+}
+
+// runOptOut implements `arkexplorer optout add|list|remove`, which manages
+// the scripts hidden from the rich list.
+func runOptOut(args []string) int {
+    usage := func() int {
+        fmt.Fprintln(os.Stderr, "usage: arkexplorer optout add (-script HEX | -address ADDR) [-note TEXT] | list | remove (-script HEX | -address ADDR)")
+        return 2
+    }
+    if len(args) == 0 {
+        return usage()
+    }
+
+    fs := flag.NewFlagSet("optout "+args[0], flag.ExitOnError)
+    script := fs.String("script", "", "Output script in hex")
+    address := fs.String("address", "", "Ark address")
+    note := fs.String("note", "", "Why the script opted out")
+    fs.Parse(args[1:])
+
+    if *address != "" {
+        s, err := arkAddressScript(*address)
+        if err != nil {
+            log.Printf("Invalid address: %v", err)
+            return 2
+        }
+        *script = s
+    }
+    *script = strings.ToLower(*script)
+
+    if err := InitDB(); err != nil {
+        log.Printf("Database error: %v", err)
+        return 1
+    }
+    ctx := context.Background()
+    DB.NewCreateTable().Model((*RichListOptOut)(nil)).IfNotExists().Exec(ctx)
+
+    switch args[0] {
+    case "add":
+        if *script == "" {
+            return usage()
+        }
+        _, err := DB.NewInsert().Model(&RichListOptOut{Script: *script, Note: *note, CreatedAt: time.Now().Unix()}).
+            On("DUPLICATE KEY UPDATE").Set("note = VALUES(note)").
+            Exec(ctx)
+        if err != nil {
+            log.Printf("Error adding opt-out: %v", err)
+            return 1
+        }
+        fmt.Printf("Script %s hidden from the rich list\n", *script)
+
+    case "list":
+        var optOuts []RichListOptOut
+        if err := DB.NewSelect().Model(&optOuts).Order("created_at ASC").Scan(ctx); err != nil {
+            log.Printf("Error listing opt-outs: %v", err)
+            return 1
+        }
+        tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+        fmt.Fprintln(tw, "SCRIPT\tADDED\tNOTE")
+        for _, o := range optOuts {
+            fmt.Fprintf(tw, "%s\t%s\t%s\n", o.Script, time.Unix(o.CreatedAt, 0).UTC().Format("2006-01-02"), o.Note)
+        }
+        tw.Flush()
+
+    case "remove":
+        if *script == "" {
+            return usage()
+        }
+        res, err := DB.NewDelete().Model((*RichListOptOut)(nil)).Where("script = ?", *script).Exec(ctx)
+        if err != nil {
+            log.Printf("Error removing opt-out: %v", err)
+            return 1
+        }
+        if n, _ := res.RowsAffected(); n == 0 {
+            log.Printf("Script %s is not opted out", *script)
+            return 1
+        }
+        fmt.Printf("Script %s shown on the rich list again\n", *script)
+
+    default:
+        return usage()
+    }
+    return 0
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+    "math"
+    "testing"
+)
+
+func TestConcentration(t *testing.T) {
+    equal := make([]int64, 12)
+    for i := range equal {
+        equal[i] = 1000
+    }
+
+    tests := []struct {
+        name     string
+        balances []int64 // largest first, as computeConcentration scans them
+        gini     float64
+        top10    float64
+    }{
+        {"no holders", nil, 0, 0},
+        {"evenly spread", equal, 0, 1000.0 / 12},
+        {"one holder of four", []int64{100, 0, 0, 0}, 0.75, 100},
+        {"three to one", []int64{3, 1}, 0.25, 100},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            var c concentration
+            for _, b := range tt.balances {
+                c.add(b)
+            }
+            stats := c.stats(defaultASP)
+            if stats.Holders != len(tt.balances) {
+                t.Errorf("holders %d, want %d", stats.Holders, len(tt.balances))
+            }
+            if math.Abs(stats.Gini-tt.gini) > 1e-9 || math.Abs(stats.TopTenShare-tt.top10) > 1e-9 {
+                t.Errorf("gini %v top10 %v, want %v %v", stats.Gini, stats.TopTenShare, tt.gini, tt.top10)
+            }
+        })
+    }
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+    "context"
     "database/sql"
+    "fmt"
     "github.com/uptrace/bun"
     "github.com/uptrace/bun/dialect/mysqldialect"
     _ "github.com/go-sql-driver/mysql"
@@ -10,11 +12,173 @@ import (
 var DB *bun.DB
 
 func InitDB() error {
-    sqldb, err := sql.Open("mysql", "root:root@tcp(localhost:3306)/ark?parseTime=true")
+    return openDB("root:root@tcp(localhost:3306)/ark?parseTime=true")
+}
+
+func openDB(dsn string) error {
+    sqldb, err := sql.Open("mysql", dsn)
     if err != nil {
         return err
     }
+    // sql.Open only validates the DSN; make sure the server is reachable
+    if err := sqldb.Ping(); err != nil {
+        sqldb.Close()
+        return fmt.Errorf("connecting to database: %w", err)
+    }
     
     DB = bun.NewDB(sqldb, mysqldialect.New())
+    DB.AddQueryHook(dbMetricsHook{})
     return nil
-}
\ No newline at end of file
+}
+
+// createTables creates the tables the server uses that do not exist yet.
+func createTables(ctx context.Context) {
+    DB.NewCreateTable().Model((*Events)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*VTXO)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*NetworkStats)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*Watch)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*WebhookDelivery)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*APIKey)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*ConcentrationStats)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*RichListOptOut)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*Round)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*TxFee)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*Alert)(nil)).IfNotExists().Exec(ctx)
+    DB.NewCreateTable().Model((*KnownScript)(nil)).IfNotExists().Exec(ctx)
+}
+
+// MigrateSchema brings tables created by older versions up to date. CREATE
+// TABLE IF NOT EXISTS leaves existing tables alone, so new columns are added here.
+func MigrateSchema(ctx context.Context) error {
+    // Events used to be keyed by receive time alone, which collides across
+    // streams
+    if err := ensureEventID(ctx); err != nil {
+        return err
+    }
+    for _, table := range []string{"events", "vtxos", "network_stats"} {
+        if err := ensureColumn(ctx, table, "asp", "VARCHAR(64) NOT NULL DEFAULT '"+defaultASP+"'"); err != nil {
+            return err
+        }
+    }
+    // Newest event per ASP for /readyz, read from the index alone
+    if err := ensureIndex(ctx, "events", "idx_events_asp_ts", "asp, timestamp_ms"); err != nil {
+        return err
+    }
+    // Spend time and sweep flag for lifetime analytics; rerun -backfill to
+    // fill them for VTXOs spent before this column existed
+    if err := ensureColumn(ctx, "vtxos", "spent_at", "BIGINT NOT NULL DEFAULT 0"); err != nil {
+        return err
+    }
+    if err := ensureColumn(ctx, "vtxos", "is_swept", "BOOLEAN NOT NULL DEFAULT FALSE"); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "vtxos", "idx_vtxos_spent_at", "spent_at"); err != nil {
+        return err
+    }
+    // Script activity counts in stats snapshots
+    for _, period := range []string{"day", "week", "month"} {
+        for _, kind := range []string{"active", "new", "returning"} {
+            if err := ensureColumn(ctx, "network_stats", kind+"_scripts_"+period, "BIGINT NOT NULL DEFAULT 0"); err != nil {
+                return err
+            }
+        }
+    }
+    if err := ensureIndex(ctx, "vtxos", "idx_vtxos_script_created", "script, created_at"); err != nil {
+        return err
+    }
+    if err := ensureKnownScripts(ctx); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "known_scripts", "idx_known_scripts_first_seen", "first_seen"); err != nil {
+        return err
+    }
+    // Per-script balances for concentration analytics and the rich list
+    if err := ensureIndex(ctx, "vtxos", "idx_vtxos_spent_script", "is_spent, script, amount"); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "concentration_stats", "idx_concentration_asp_ts", "asp, timestamp"); err != nil {
+        return err
+    }
+    // Keyset pagination on /api/v1/rounds and cadence per ASP
+    if err := ensureIndex(ctx, "rounds", "idx_rounds_created_txid", "created_at, txid"); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "rounds", "idx_rounds_asp_created", "asp, created_at"); err != nil {
+        return err
+    }
+    // Inputs of a round on /api/v1/rounds/{txid}
+    if err := ensureIndex(ctx, "vtxos", "idx_vtxos_spent_by", "spent_by"); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "tx_fees", "idx_tx_fees_created", "created_at"); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "alerts", "idx_alerts_asp_metric", "asp, metric, created_at"); err != nil {
+        return err
+    }
+    // Keyset pagination on /api/transactions
+    if err := ensureIndex(ctx, "vtxos", "idx_vtxos_created_txid", "created_at, txid"); err != nil {
+        return err
+    }
+    if err := ensureIndex(ctx, "vtxos", "idx_vtxos_spent_at_by", "spent_at, spent_by"); err != nil {
+        return err
+    }
+    return nil
+}
+
+func ensureColumn(ctx context.Context, table, column, definition string) error {
+    var count int
+    err := DB.NewSelect().
+        TableExpr("information_schema.columns").
+        ColumnExpr("COUNT(*)").
+        Where("table_schema = DATABASE() AND table_name = ? AND column_name = ?", table, column).
+        Scan(ctx, &count)
+    if err != nil || count > 0 {
+        return err
+    }
+    _, err = DB.ExecContext(ctx, "ALTER TABLE "+table+" ADD COLUMN "+column+" "+definition)
+    return err
+}
+
+// ensureKnownScripts fills known_scripts from vtxos the first time it is
+// empty; the ingester keeps it current from then on.
+func ensureKnownScripts(ctx context.Context) error {
+    exists, err := DB.NewSelect().Model((*KnownScript)(nil)).Limit(1).Exists(ctx)
+    if err != nil || exists {
+        return err
+    }
+    _, err = DB.ExecContext(ctx, "INSERT INTO known_scripts (script, asp, first_seen) "+
+        "SELECT script, asp, MIN(created_at) FROM vtxos GROUP BY script, asp")
+    return err
+}
+
+// ensureEventID replaces the timestamp_ms primary key of events with an
+// auto-increment id.
+func ensureEventID(ctx context.Context) error {
+    var count int
+    err := DB.NewSelect().
+        TableExpr("information_schema.columns").
+        ColumnExpr("COUNT(*)").
+        Where("table_schema = DATABASE() AND table_name = 'events' AND column_name = 'id'").
+        Scan(ctx, &count)
+    if err != nil || count > 0 {
+        return err
+    }
+    _, err = DB.ExecContext(ctx, "ALTER TABLE events DROP PRIMARY KEY, "+
+        "ADD COLUMN id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY FIRST")
+    return err
+}
+
+func ensureIndex(ctx context.Context, table, name, columns string) error {
+    var count int
+    err := DB.NewSelect().
+        TableExpr("information_schema.statistics").
+        ColumnExpr("COUNT(*)").
+        Where("table_schema = DATABASE() AND table_name = ? AND index_name = ?", table, name).
+        Scan(ctx, &count)
+    if err != nil || count > 0 {
+        return err
+    }
+    _, err = DB.ExecContext(ctx, "CREATE INDEX "+name+" ON "+table+" ("+columns+")")
+    return err
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+    "context"
+    "crypto/rand"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "log"
+    "net/http"
+)
+
+// APIError is an error with the status and code reported to the client.
+type APIError struct {
+    Status  int
+    Code    string
+    Message string
+    Err     error // underlying cause, logged but never sent to clients
+}
+
+func (e *APIError) Error() string {
+    if e.Err != nil {
+        return e.Message + ": " + e.Err.Error()
+    }
+    return e.Message
+}
+
+func (e *APIError) Unwrap() error { return e.Err }
+
+func badRequest(format string, args ...interface{}) *APIError {
+    return &APIError{Status: http.StatusBadRequest, Code: "bad_request", Message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...interface{}) *APIError {
+    return &APIError{Status: http.StatusNotFound, Code: "not_found", Message: fmt.Sprintf(format, args...)}
+}
+
+func unauthorized(format string, args ...interface{}) *APIError {
+    return &APIError{Status: http.StatusUnauthorized, Code: "unauthorized", Message: fmt.Sprintf(format, args...)}
+}
+
+func tooManyRequests() *APIError {
+    return &APIError{Status: http.StatusTooManyRequests, Code: "rate_limited", Message: "rate limit exceeded"}
+}
+
+func forbidden(format string, args ...interface{}) *APIError {
+    return &APIError{Status: http.StatusForbidden, Code: "forbidden", Message: fmt.Sprintf(format, args...)}
+}
+
+func methodNotAllowed() *APIError {
+    return &APIError{Status: http.StatusMethodNotAllowed, Code: "method_not_allowed", Message: "method not allowed"}
+}
+
+// internalError hides err from the client; it is logged with the request id.
+func internalError(err error) *APIError {
+    return &APIError{Status: http.StatusInternalServerError, Code: "internal", Message: "internal server error", Err: err}
+}
+
+// apiHandler is a handler that reports failures by returning an error instead
+// of writing its own error response.
+type apiHandler func(w http.ResponseWriter, r *http.Request) error
+
+// handleErrors adapts h to http.HandlerFunc, turning returned errors into the
+// JSON error envelope. Errors that are not *APIError become 500s.
+func handleErrors(h apiHandler) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        if err := h(w, r); err != nil {
+            writeError(w, r, err)
+        }
+    }
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+    var apiErr *APIError
+    if !errors.As(err, &apiErr) {
+        apiErr = internalError(err)
+    }
+    if apiErr.Status >= 500 {
+        logf(r.Context(), "%s %s: %v", r.Method, r.URL.Path, apiErr)
+    }
+
+    writeJSON(w, apiErr.Status, map[string]interface{}{
+        "error": map[string]string{
+            "code":      apiErr.Code,
+            "message":   apiErr.Message,
+            "requestId": requestID(r.Context()),
+        },
+    })
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) error {
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(status)
+    return json.NewEncoder(w).Encode(v)
+}
+
+type requestIDKey struct{}
+
+// withRequestID tags each request with an id, reusing X-Request-ID when the
+// proxy already set one, and echoes it in the response.
+func withRequestID(next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        id := r.Header.Get("X-Request-ID")
+        if id == "" || len(id) > 64 {
+            b := make([]byte, 8)
+            rand.Read(b)
+            id = hex.EncodeToString(b)
+        }
+        w.Header().Set("X-Request-ID", id)
+        next(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+    }
+}
+
+func requestID(ctx context.Context) string {
+    id, _ := ctx.Value(requestIDKey{}).(string)
+    return id
+}
+
+// logf logs with the request id of ctx, if any.
+func logf(ctx context.Context, format string, args ...interface{}) {
+    if id := requestID(ctx); id != "" {
+        format = "[req " + id + "] " + format
+    }
+    log.Printf(format, args...)
+}
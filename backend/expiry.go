@@ -0,0 +1,106 @@
+package main
+
+import (
+    "net/http"
+    "strconv"
+    "time"
+)
+
+// GetExpiryCalendar reports unspent liquidity by expiry time so treasury can
+// see how much the ASP will reclaim and how much users still need to refresh.
+func GetExpiryCalendar(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+    query := r.URL.Query()
+
+    days := 7
+    if v := query.Get("days"); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n <= 0 || n > 90 {
+            return badRequest("days must be between 1 and 90")
+        }
+        days = n
+    }
+
+    bucket := query.Get("bucket")
+    dateFormat := "DATE(FROM_UNIXTIME(expires_at))"
+    switch bucket {
+    case "hour":
+        dateFormat = "DATE_FORMAT(FROM_UNIXTIME(expires_at), '%Y-%m-%d %H:00')"
+    case "", "day":
+        bucket = "day"
+    default:
+        return badRequest("bucket must be hour or day")
+    }
+
+    limit := 20
+    if v := query.Get("limit"); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n <= 0 || n > 500 {
+            return badRequest("limit must be between 1 and 500")
+        }
+        limit = n
+    }
+
+    now := time.Now().Unix()
+    horizon := now + int64(days)*24*3600
+
+    type ExpiryBucket struct {
+        DisplayDate string  `json:"displayDate" bun:"display_date"`
+        Amount      float64 `json:"amount" bun:"amount"`
+        Count       int     `json:"count" bun:"count"`
+    }
+
+    calendar := make([]ExpiryBucket, 0)
+    err := filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).
+        ColumnExpr(dateFormat + " AS display_date").
+        ColumnExpr("SUM(amount) / 100000000.0 AS amount").
+        ColumnExpr("COUNT(*) AS count").
+        Where("is_spent = ? AND expires_at >= ? AND expires_at < ?", false, now, horizon).
+        Group("display_date").
+        Order("display_date ASC").
+        Scan(ctx, &calendar)
+    if err != nil {
+        return err
+    }
+
+    // Past expiry but not yet swept by the ASP, as isSweptExpr defines swept
+    var expired struct {
+        Amount float64 `bun:"amount"`
+        Count  int     `bun:"count"`
+    }
+    err = filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).
+        ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0 AS amount").
+        ColumnExpr("COUNT(*) AS count").
+        Where("is_spent = ? AND expires_at > 0 AND expires_at < ? AND NOT "+isSweptExpr, false, now).
+        Scan(ctx, &expired)
+    if err != nil {
+        return err
+    }
+
+    // Largest VTXOs that are expired or will expire inside the window
+    atRisk := make([]VTXO, 0)
+    err = filterASP(DB.NewSelect().Model(&atRisk), r).
+        Where("is_spent = ? AND expires_at > 0 AND expires_at < ? AND NOT "+isSweptExpr, false, horizon).
+        Order("amount DESC").
+        Limit(limit).
+        Scan(ctx)
+    if err != nil {
+        return err
+    }
+
+    var expiringTotal float64
+    for _, b := range calendar {
+        expiringTotal += b.Amount
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "days":           days,
+        "bucket":         bucket,
+        "calendar":       calendar,
+        "expiringVolume": expiringTotal,
+        "expiredVolume":  expired.Amount,
+        "expiredCount":   expired.Count,
+        "atRisk":         atRisk,
+        "timestamp":      now * 1000,
+    })
+}
@@ -0,0 +1,318 @@
+package main
+
+import (
+    "context"
+    "encoding/csv"
+    "encoding/json"
+    "flag"
+    "fmt"
+    "io"
+    "log"
+    "net/http"
+    "os"
+    "strconv"
+    "time"
+
+    "github.com/parquet-go/parquet-go"
+    "github.com/uptrace/bun"
+)
+
+// exportRecord is a row type that can be written by every export format.
+type exportRecord interface {
+    VTXO | NetworkStats | TrendPoint
+}
+
+func csvHeader(v interface{}) []string {
+    switch v.(type) {
+    case *VTXO:
+        return []string{"txid", "vout", "amount", "script", "created_at", "expires_at", "is_spent", "spent_by", "spent_at", "is_swept", "tx_type", "asp"}
+    case *NetworkStats:
+        return []string{"id", "timestamp", "onboarding_volume", "offboarding_volume", "network_liquidity", "virtual_tx_count", "virtual_tx_volume",
+            "active_scripts_day", "new_scripts_day", "returning_scripts_day", "active_scripts_week", "new_scripts_week",
+            "returning_scripts_week", "active_scripts_month", "new_scripts_month", "returning_scripts_month", "asp"}
+    case *TrendPoint:
+        return []string{"display_date", "onboarding_volume", "offboarding_volume", "virtual_tx_volume", "virtual_tx_count",
+            "active_scripts", "new_scripts", "returning_scripts", "refresh_count", "refresh_volume", "asp"}
+    }
+    return nil
+}
+
+func csvRecord(v interface{}) []string {
+    i64 := func(n int64) string { return strconv.FormatInt(n, 10) }
+    f64 := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+    switch r := v.(type) {
+    case *VTXO:
+        return []string{r.Txid, strconv.Itoa(r.Vout), i64(r.Amount), r.Script, i64(r.CreatedAt), i64(r.ExpiresAt),
+            strconv.FormatBool(r.IsSpent), r.SpentBy, i64(r.SpentAt), strconv.FormatBool(r.IsSwept), r.TxType, r.Asp}
+    case *NetworkStats:
+        return []string{strconv.Itoa(r.ID), i64(r.Timestamp), i64(r.OnboardingVolume), i64(r.OffboardingVolume),
+            i64(r.NetworkLiquidity), strconv.Itoa(r.VirtualTxCount), i64(r.VirtualTxVolume),
+            strconv.Itoa(r.ActiveScriptsDay), strconv.Itoa(r.NewScriptsDay), strconv.Itoa(r.ReturningScriptsDay),
+            strconv.Itoa(r.ActiveScriptsWeek), strconv.Itoa(r.NewScriptsWeek), strconv.Itoa(r.ReturningScriptsWeek),
+            strconv.Itoa(r.ActiveScriptsMonth), strconv.Itoa(r.NewScriptsMonth), strconv.Itoa(r.ReturningScriptsMonth), r.Asp}
+    case *TrendPoint:
+        return []string{r.DisplayDate, f64(r.OnboardingVolume), f64(r.OffboardingVolume), f64(r.VirtualTxVolume),
+            strconv.Itoa(r.VirtualTxCount), strconv.Itoa(r.ActiveScripts), strconv.Itoa(r.NewScripts),
+            strconv.Itoa(r.ReturningScripts), strconv.Itoa(r.RefreshCount), f64(r.RefreshVolume), r.Asp}
+    }
+    return nil
+}
+
+// exportWriter encodes rows one at a time so exports never hold the full
+// result set in memory.
+type exportWriter[T exportRecord] interface {
+    Write(row *T) error
+    Close() error
+}
+
+type csvExportWriter[T exportRecord] struct {
+    w      *csv.Writer
+    header bool
+}
+
+func (e *csvExportWriter[T]) Write(row *T) error {
+    if !e.header {
+        e.header = true
+        if err := e.w.Write(csvHeader(row)); err != nil {
+            return err
+        }
+    }
+    return e.w.Write(csvRecord(row))
+}
+
+func (e *csvExportWriter[T]) Close() error {
+    if !e.header {
+        var zero T
+        e.w.Write(csvHeader(&zero))
+    }
+    e.w.Flush()
+    return e.w.Error()
+}
+
+type ndjsonExportWriter[T exportRecord] struct {
+    enc *json.Encoder
+}
+
+func (e *ndjsonExportWriter[T]) Write(row *T) error { return e.enc.Encode(row) }
+
+func (e *ndjsonExportWriter[T]) Close() error { return nil }
+
+type parquetExportWriter[T exportRecord] struct {
+    w *parquet.GenericWriter[T]
+}
+
+func (e *parquetExportWriter[T]) Write(row *T) error {
+    _, err := e.w.Write([]T{*row})
+    return err
+}
+
+func (e *parquetExportWriter[T]) Close() error { return e.w.Close() }
+
+func newExportWriter[T exportRecord](w io.Writer, format string) (exportWriter[T], error) {
+    switch format {
+    case "csv":
+        return &csvExportWriter[T]{w: csv.NewWriter(w)}, nil
+    case "ndjson":
+        return &ndjsonExportWriter[T]{enc: json.NewEncoder(w)}, nil
+    case "parquet":
+        return &parquetExportWriter[T]{w: parquet.NewGenericWriter[T](w)}, nil
+    }
+    return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// iterateRows runs q and hands each row to fn as it is read from MySQL.
+func iterateRows[T any](ctx context.Context, q *bun.SelectQuery, fn func(*T) error) error {
+    rows, err := q.Rows(ctx)
+    if err != nil {
+        return err
+    }
+    defer rows.Close()
+
+    for rows.Next() {
+        row := new(T)
+        if err := DB.ScanRow(ctx, rows, row); err != nil {
+            return err
+        }
+        if err := fn(row); err != nil {
+            return err
+        }
+    }
+    return rows.Err()
+}
+
+// exportFilter selects the rows of a VTXO or stats export. From and To are
+// unix seconds; zero means unbounded.
+type exportFilter struct {
+    From      int64
+    To        int64
+    Types     []string
+    Asp       string
+    Timeframe string
+}
+
+func vtxoExportQuery(f exportFilter) *bun.SelectQuery {
+    q := whereASP(DB.NewSelect().Model((*VTXO)(nil)), f.Asp).Order("created_at ASC", "txid ASC", "vout ASC")
+    if f.From > 0 {
+        q = q.Where("created_at >= ?", f.From)
+    }
+    if f.To > 0 {
+        q = q.Where("created_at < ?", f.To)
+    }
+    if len(f.Types) > 0 {
+        q = q.Where("tx_type IN (?)", bun.In(f.Types))
+    }
+    return q
+}
+
+func statsExportQuery(f exportFilter) *bun.SelectQuery {
+    // network_stats timestamps are in milliseconds
+    q := whereASP(DB.NewSelect().Model((*NetworkStats)(nil)), f.Asp).Order("timestamp ASC", "id ASC")
+    if f.From > 0 {
+        q = q.Where("timestamp >= ?", f.From*1000)
+    }
+    if f.To > 0 {
+        q = q.Where("timestamp < ?", f.To*1000)
+    }
+    return q
+}
+
+// writeExport streams dataset ("vtxos", "stats" or "trends") to w.
+func writeExport(ctx context.Context, w io.Writer, dataset, format string, f exportFilter) error {
+    switch dataset {
+    case "vtxos":
+        return streamExport[VTXO](ctx, w, format, vtxoExportQuery(f))
+    case "stats":
+        return streamExport[NetworkStats](ctx, w, format, statsExportQuery(f))
+    case "trends":
+        ew, err := newExportWriter[TrendPoint](w, format)
+        if err != nil {
+            return err
+        }
+        // Trends are already aggregated to at most a few thousand points
+        points, err := queryTrends(ctx, f.Timeframe, f.Asp, false)
+        if err != nil {
+            return err
+        }
+        for i := range points {
+            if err := ew.Write(&points[i]); err != nil {
+                return err
+            }
+        }
+        return ew.Close()
+    }
+    return fmt.Errorf("unknown dataset %q", dataset)
+}
+
+func streamExport[T exportRecord](ctx context.Context, w io.Writer, format string, q *bun.SelectQuery) error {
+    ew, err := newExportWriter[T](w, format)
+    if err != nil {
+        return err
+    }
+    if err := iterateRows(ctx, q, ew.Write); err != nil {
+        return err
+    }
+    return ew.Close()
+}
+
+// GetExport serves /api/v1/export/{dataset}?format=csv|ndjson for the
+// vtxos, stats and trends datasets.
+func GetExport(w http.ResponseWriter, r *http.Request) error {
+    dataset := r.PathValue("dataset")
+    switch dataset {
+    case "vtxos", "stats", "trends":
+    default:
+        return notFound("unknown dataset %q", dataset)
+    }
+
+    query := r.URL.Query()
+
+    format := query.Get("format")
+    if format == "" {
+        format = "csv"
+    }
+    contentType := map[string]string{"csv": "text/csv", "ndjson": "application/x-ndjson"}[format]
+    if contentType == "" {
+        return badRequest("format must be csv or ndjson")
+    }
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    f := exportFilter{
+        Types:     splitList(query.Get("type")),
+        Asp:       query.Get("asp"),
+        Timeframe: timeframe,
+    }
+    if f.From, err = int64Param(r, "from"); err != nil {
+        return err
+    }
+    if f.To, err = int64Param(r, "to"); err != nil {
+        return err
+    }
+
+    w.Header().Set("Content-Type", contentType)
+    w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", dataset+"."+format))
+
+    // Headers are already sent once rows stream, so errors can only be logged
+    if err := writeExport(r.Context(), w, dataset, format, f); err != nil {
+        logf(r.Context(), "Export %s failed: %v", dataset, err)
+    }
+    return nil
+}
+
+// runExport implements `arkexplorer export`, which writes a dataset to a file
+// for the data warehouse. Parquet is the default format.
+func runExport(args []string) int {
+    fs := flag.NewFlagSet("export", flag.ExitOnError)
+    dataset := fs.String("dataset", "vtxos", "Dataset to export: vtxos, stats or trends")
+    format := fs.String("format", "parquet", "Output format: parquet, csv or ndjson")
+    out := fs.String("out", "", "Output file (default <dataset>.<format>)")
+    from := fs.String("from", "", "Start date (YYYY-MM-DD, inclusive)")
+    to := fs.String("to", "", "End date (YYYY-MM-DD, exclusive)")
+    types := fs.String("type", "", "Comma separated tx types (vtxos only)")
+    asp := fs.String("asp", "", "Restrict to one ASP")
+    timeframe := fs.String("timeframe", "all time", "Timeframe (trends only)")
+    fs.Parse(args)
+
+    f := exportFilter{Types: splitList(*types), Asp: *asp, Timeframe: *timeframe}
+    for _, d := range []struct {
+        value string
+        dst   *int64
+    }{{*from, &f.From}, {*to, &f.To}} {
+        if d.value == "" {
+            continue
+        }
+        t, err := time.Parse("2006-01-02", d.value)
+        if err != nil {
+            log.Printf("Invalid date %q: %v", d.value, err)
+            return 2
+        }
+        *d.dst = t.Unix()
+    }
+
+    path := *out
+    if path == "" {
+        path = *dataset + "." + *format
+    }
+
+    if err := InitDB(); err != nil {
+        log.Printf("Database error: %v", err)
+        return 1
+    }
+
+    file, err := os.Create(path)
+    if err != nil {
+        log.Printf("Error creating %s: %v", path, err)
+        return 1
+    }
+    defer file.Close()
+
+    if err := writeExport(context.Background(), file, *dataset, *format, f); err != nil {
+        log.Printf("Export failed: %v", err)
+        return 1
+    }
+
+    fmt.Printf("Exported %s to %s\n", *dataset, path)
+    return 0
+}
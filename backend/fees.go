@@ -0,0 +1,186 @@
+package main
+
+import (
+    "context"
+    "log"
+    "net/http"
+    "time"
+
+    "github.com/uptrace/bun"
+)
+
+// txFeeFromTx balances the VTXOs a transaction spends against those it
+// creates.
+func txFeeFromTx(tx *parsedTx) *TxFee {
+    fee := &TxFee{
+        Txid:         tx.Txid,
+        Asp:          tx.Asp,
+        CreatedAt:    tx.CreatedAt,
+        IsCommitment: tx.IsCommitment,
+        OutputCount:  len(tx.Outputs),
+    }
+    for _, in := range tx.Inputs {
+        if in.IsSwept {
+            continue
+        }
+        fee.InputCount++
+        fee.InputAmount += in.Amount
+    }
+    for _, out := range tx.Outputs {
+        fee.OutputAmount += out.Amount
+    }
+    fee.Fee = fee.InputAmount - fee.OutputAmount
+    return fee
+}
+
+// isUnbalanced reports an ark transaction that cannot be right: it spends
+// nothing or creates more than it spends. Rounds have L1 legs we do not
+// see, so they cannot be checked.
+func (f *TxFee) isUnbalanced() bool {
+    return !f.IsCommitment && (f.InputCount == 0 || f.Fee < 0)
+}
+
+// storeTxFee upserts the value balance of tx; later events for the same
+// transaction win, as for rounds.
+func storeTxFee(ctx context.Context, tx *parsedTx) {
+    fee := txFeeFromTx(tx)
+    if fee.InputCount == 0 && fee.OutputCount == 0 {
+        return
+    }
+    _, err := DB.NewInsert().Model(fee).
+        On("DUPLICATE KEY UPDATE").
+        Set("created_at = VALUES(created_at)").
+        Set("is_commitment = VALUES(is_commitment)").
+        Set("input_count = VALUES(input_count)").
+        Set("output_count = VALUES(output_count)").
+        Set("input_amount = VALUES(input_amount)").
+        Set("output_amount = VALUES(output_amount)").
+        Set("fee = VALUES(fee)").
+        Exec(ctx)
+    if err != nil {
+        log.Printf("Error storing fee of %s: %v", tx.Txid, err)
+    }
+}
+
+const feeKindExpr = "CASE WHEN is_commitment THEN 'rounds' ELSE 'ark' END"
+
+// GetFeeAnalytics reports implied fees (value in minus value out) of ark
+// transactions for the timeframe, with a per-bucket series. Rounds have L1
+// legs we do not see, so the same difference is only their net flow, not a
+// fee.
+func GetFeeAnalytics(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    now := time.Now().Unix()
+    var since int64
+    if window := timeframeSeconds(timeframe); window > 0 {
+        since = now - window
+    }
+
+    type FeeSummary struct {
+        Kind        string   `bun:"kind" json:"-"`
+        Count       int      `bun:"count" json:"count"`
+        FeePaying   int      `bun:"fee_paying" json:"feePaying"`
+        TotalFees   float64  `bun:"total_fees" json:"totalFees"`
+        Volume      float64  `bun:"volume" json:"volume"`
+        MeanFeeSats float64  `bun:"mean_fee" json:"meanFeeSats"`
+        P50FeeSats  int64    `bun:"-" json:"p50FeeSats"`
+        P90FeeSats  int64    `bun:"-" json:"p90FeeSats"`
+        P99FeeSats  int64    `bun:"-" json:"p99FeeSats"`
+        FeeRateBps  *float64 `bun:"-" json:"feeRateBps"`
+    }
+
+    window := func() *bun.SelectQuery {
+        return filterASP(DB.NewSelect().Model((*TxFee)(nil)), r).Where("created_at >= ?", since)
+    }
+
+    var rows []FeeSummary
+    err = window().
+        ColumnExpr(feeKindExpr+" AS kind").
+        ColumnExpr("COUNT(*) AS count").
+        ColumnExpr("COUNT(CASE WHEN fee > 0 THEN 1 END) AS fee_paying").
+        ColumnExpr("COALESCE(SUM(fee), 0) / 100000000.0 AS total_fees").
+        ColumnExpr("COALESCE(SUM(output_amount), 0) / 100000000.0 AS volume").
+        ColumnExpr("COALESCE(AVG(fee), 0) AS mean_fee").
+        Group("kind").
+        Scan(ctx, &rows)
+    if err != nil {
+        return err
+    }
+    percentiles, err := nearestRankPercentiles(ctx, window().Where("NOT is_commitment"), feeKindExpr, "fee", []int{500, 900, 990})
+    if err != nil {
+        return err
+    }
+
+    ark, rounds := &FeeSummary{Kind: "ark"}, &FeeSummary{Kind: "rounds"}
+    for i := range rows {
+        if rows[i].Kind == "ark" {
+            ark = &rows[i]
+        } else {
+            rounds = &rows[i]
+        }
+    }
+    if p := percentiles["ark"]; p != nil {
+        ark.P50FeeSats, ark.P90FeeSats, ark.P99FeeSats = p[0], p[1], p[2]
+    }
+    if ark.Volume > 0 {
+        bps := ark.TotalFees / ark.Volume * 10000
+        ark.FeeRateBps = &bps
+    }
+
+    // Forfeited inputs minus outputs; boarding and exits settle on L1
+    type RoundNetFlow struct {
+        Count           int     `json:"count"`
+        Volume          float64 `json:"volume"`
+        NetFlow         float64 `json:"netFlow"`
+        MeanNetFlowSats float64 `json:"meanNetFlowSats"`
+    }
+
+    dateFormat := bucketDateFormat(timeframe)
+    type FeeBucket struct {
+        DisplayDate  string   `bun:"display_date" json:"displayDate"`
+        ArkTxCount   int      `bun:"ark_tx_count" json:"arkTxCount"`
+        ArkFees      float64  `bun:"ark_fees" json:"arkFees"`
+        ArkVolume    float64  `bun:"ark_volume" json:"arkVolume"`
+        RoundCount   int      `bun:"round_count" json:"roundCount"`
+        RoundNetFlow float64  `bun:"round_net_flow" json:"roundNetFlow"`
+        FeeRateBps   *float64 `bun:"-" json:"feeRateBps"`
+    }
+    series := make([]FeeBucket, 0)
+    err = window().
+        ColumnExpr(dateFormat+" AS display_date").
+        ColumnExpr("COUNT(CASE WHEN NOT is_commitment THEN 1 END) AS ark_tx_count").
+        ColumnExpr("COALESCE(SUM(CASE WHEN NOT is_commitment THEN fee END), 0) / 100000000.0 AS ark_fees").
+        ColumnExpr("COALESCE(SUM(CASE WHEN NOT is_commitment THEN output_amount END), 0) / 100000000.0 AS ark_volume").
+        ColumnExpr("COUNT(CASE WHEN is_commitment THEN 1 END) AS round_count").
+        ColumnExpr("COALESCE(SUM(CASE WHEN is_commitment THEN fee END), 0) / 100000000.0 AS round_net_flow").
+        Group("display_date").
+        Order("display_date").
+        Scan(ctx, &series)
+    if err != nil {
+        return err
+    }
+    for i := range series {
+        if b := &series[i]; b.ArkVolume > 0 {
+            bps := b.ArkFees / b.ArkVolume * 10000
+            b.FeeRateBps = &bps
+        }
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "ark":       ark,
+        "rounds": RoundNetFlow{
+            Count:           rounds.Count,
+            Volume:          rounds.Volume,
+            NetFlow:         rounds.TotalFees,
+            MeanNetFlowSats: rounds.MeanFeeSats,
+        },
+        "series":    series,
+        "timestamp": now * 1000,
+    })
+}
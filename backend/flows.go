@@ -0,0 +1,218 @@
+package main
+
+import (
+    "context"
+    "net/http"
+    "time"
+)
+
+// liquidityFlows splits the VTXOs created and spent over a period by what
+// moved them, in sats. Every created VTXO adds to liquidity and every spent
+// or swept one takes from it, so the flows add up to the change in liquidity.
+//
+// Transactions are split like roundFromTx: refreshed inputs (isRefreshedExpr)
+// are reissued first, then in rounds the rest of the outputs boarded and the
+// rest of the inputs exited cooperatively. Sweeps are VTXOs the ASP reclaimed
+// at expiry and nobody refreshed (isSweptExpr). A VTXO spent by a transaction
+// that is neither a round nor a recorded ark transaction left without the
+// ASP, which is a unilateral exit.
+type liquidityFlows struct {
+    Bucket           int64 `bun:"bucket"`
+    Onboarding       int64 `bun:"onboarding"`
+    CooperativeExits int64 `bun:"cooperative_exits"`
+    UnilateralExits  int64 `bun:"unilateral_exits"`
+    Sweeps           int64 `bun:"sweeps"`
+    Refreshed        int64 `bun:"refreshed"`
+    Reissued         int64 `bun:"reissued"`
+    TransfersCreated int64 `bun:"transfers_created"`
+    TransfersSpent   int64 `bun:"transfers_spent"`
+}
+
+func (f *liquidityFlows) add(o *liquidityFlows) {
+    f.Onboarding += o.Onboarding
+    f.CooperativeExits += o.CooperativeExits
+    f.UnilateralExits += o.UnilateralExits
+    f.Sweeps += o.Sweeps
+    f.Refreshed += o.Refreshed
+    f.Reissued += o.Reissued
+    f.TransfersCreated += o.TransfersCreated
+    f.TransfersSpent += o.TransfersSpent
+}
+
+// net is the change in liquidity the flows account for.
+func (f *liquidityFlows) net() int64 {
+    return f.Onboarding - f.CooperativeExits - f.UnilateralExits - f.Sweeps -
+        f.Refreshed + f.Reissued + f.TransfersCreated - f.TransfersSpent
+}
+
+// liquidityAt sums the VTXOs that existed and were unspent at the given
+// time (unix seconds), leaving out those swept before it.
+func liquidityAt(ctx context.Context, asp string, at int64) (int64, error) {
+    var sats int64
+    err := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("CAST(COALESCE(SUM(amount), 0) AS SIGNED)").
+        Where("created_at < ? AND (is_spent = ? OR spent_at >= ?)", at, false, at).
+        Where("NOT ("+isSweptExpr+" AND expires_at < ?)", at).
+        Scan(ctx, &sats)
+    return sats, err
+}
+
+// flowSeries returns the flows of VTXOs created, spent or swept in
+// [from, to), bucketed by width seconds. Each bucket starts at Bucket, unix
+// seconds.
+func flowSeries(ctx context.Context, asp string, from, to, width int64) ([]liquidityFlows, error) {
+    created := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("txid AS tx").
+        ColumnExpr("FLOOR(created_at / ?) * ? AS bucket", width, width).
+        ColumnExpr("amount AS created").
+        ColumnExpr("0 AS forfeited").
+        ColumnExpr("0 AS refreshed").
+        ColumnExpr("0 AS swept").
+        Where("created_at >= ? AND created_at < ?", from, to)
+    spent := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("spent_by AS tx").
+        ColumnExpr("FLOOR(spent_at / ?) * ? AS bucket", width, width).
+        ColumnExpr("0 AS created").
+        ColumnExpr("CASE WHEN "+isRefreshedExpr+" THEN 0 ELSE amount END AS forfeited").
+        ColumnExpr("CASE WHEN "+isRefreshedExpr+" THEN amount ELSE 0 END AS refreshed").
+        ColumnExpr("0 AS swept").
+        Where("is_spent = ? AND spent_at >= ? AND spent_at < ?", true, from, to)
+    // Sweeps belong to no transaction, so they share one group per bucket
+    swept := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("NULL AS tx").
+        ColumnExpr("FLOOR(expires_at / ?) * ? AS bucket", width, width).
+        ColumnExpr("0 AS created").
+        ColumnExpr("0 AS forfeited").
+        ColumnExpr("0 AS refreshed").
+        ColumnExpr("amount AS swept").
+        Where(isSweptExpr+" AND expires_at >= ? AND expires_at < ?", from, to)
+
+    // Both legs of a transaction share its time, so they meet in one bucket
+    isRound := DB.NewSelect().Model((*Round)(nil)).ColumnExpr("1").Where("round.txid = legs.tx")
+    isArkTx := DB.NewSelect().Model((*TxFee)(nil)).ColumnExpr("1").Where("tx_fee.txid = legs.tx")
+    legs := DB.NewSelect().
+        TableExpr("(?) AS legs", created.UnionAll(spent).UnionAll(swept)).
+        ColumnExpr("legs.bucket, legs.tx").
+        ColumnExpr("SUM(legs.forfeited) AS forfeited").
+        ColumnExpr("SUM(legs.refreshed) AS refreshed").
+        ColumnExpr("LEAST(SUM(legs.created), SUM(legs.refreshed)) AS reissued").
+        ColumnExpr("SUM(legs.created) - LEAST(SUM(legs.created), SUM(legs.refreshed)) AS created").
+        ColumnExpr("SUM(legs.swept) AS swept").
+        ColumnExpr("CASE WHEN EXISTS (?) THEN 'round' "+
+            "WHEN SUM(legs.created) = 0 AND NOT EXISTS (?) THEN 'unilateral' "+
+            "ELSE 'ark' END AS kind", isRound, isArkTx).
+        GroupExpr("legs.bucket, legs.tx")
+
+    // created is what is left after reissuing refreshed inputs
+    series := make([]liquidityFlows, 0)
+    err := DB.NewSelect().
+        TableExpr("(?) AS txs", legs).
+        ColumnExpr("bucket").
+        ColumnExpr("CAST(SUM(CASE WHEN kind = 'round' THEN created - LEAST(created, forfeited) ELSE 0 END) AS SIGNED) AS onboarding").
+        ColumnExpr("CAST(SUM(CASE WHEN kind = 'round' THEN forfeited - LEAST(created, forfeited) ELSE 0 END) AS SIGNED) AS cooperative_exits").
+        ColumnExpr("CAST(SUM(CASE WHEN kind = 'unilateral' THEN forfeited ELSE 0 END) AS SIGNED) AS unilateral_exits").
+        ColumnExpr("CAST(SUM(swept) AS SIGNED) AS sweeps").
+        ColumnExpr("CAST(SUM(refreshed) AS SIGNED) AS refreshed").
+        ColumnExpr("CAST(SUM(reissued) AS SIGNED) AS reissued").
+        ColumnExpr("CAST(SUM(CASE WHEN kind = 'ark' THEN created ELSE 0 END) AS SIGNED) AS transfers_created").
+        ColumnExpr("CAST(SUM(CASE WHEN kind = 'ark' THEN forfeited ELSE 0 END) AS SIGNED) AS transfers_spent").
+        Group("bucket").
+        Order("bucket").
+        Scan(ctx, &series)
+    return series, err
+}
+
+// FlowStep is a waterfall step in BTC. Outflows are negative, so ending
+// liquidity is starting liquidity plus every flow.
+type FlowStep struct {
+    Start             int64   `json:"start"`
+    DisplayDate       string  `json:"displayDate,omitempty"`
+    StartingLiquidity float64 `json:"startingLiquidity"`
+    Onboarding        float64 `json:"onboarding"`
+    CooperativeExits  float64 `json:"cooperativeExits"`
+    UnilateralExits   float64 `json:"unilateralExits"`
+    Sweeps            float64 `json:"sweeps"`
+    RefreshForfeited  float64 `json:"refreshForfeited"`
+    RefreshReissued   float64 `json:"refreshReissued"`
+    TransfersSpent    float64 `json:"transfersSpent"`
+    TransfersCreated  float64 `json:"transfersCreated"`
+    EndingLiquidity   float64 `json:"endingLiquidity"`
+}
+
+func flowStep(start, starting int64, f *liquidityFlows) FlowStep {
+    btc := func(sats int64) float64 { return float64(sats) / 100000000.0 }
+    return FlowStep{
+        Start:             start,
+        StartingLiquidity: btc(starting),
+        Onboarding:        btc(f.Onboarding),
+        CooperativeExits:  -btc(f.CooperativeExits),
+        UnilateralExits:   -btc(f.UnilateralExits),
+        Sweeps:            -btc(f.Sweeps),
+        RefreshForfeited:  -btc(f.Refreshed),
+        RefreshReissued:   btc(f.Reissued),
+        TransfersSpent:    -btc(f.TransfersSpent),
+        TransfersCreated:  btc(f.TransfersCreated),
+        EndingLiquidity:   btc(starting + f.net()),
+    }
+}
+
+// GetFlows breaks the change in liquidity over the timeframe into what
+// moved it, per bucket for a waterfall chart. Ending liquidity is measured
+// independently of the flows; the residual between the two is zero when
+// every VTXO was created before it was spent.
+func GetFlows(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    asp := r.URL.Query().Get("asp")
+    now := time.Now().Unix()
+    var from int64
+    if window := timeframeSeconds(timeframe); window > 0 {
+        from = now - window
+    }
+    width, layout := int64(24*3600), "2006-01-02"
+    if timeframe == "24h" {
+        width, layout = 3600, "2006-01-02 15:00"
+    }
+
+    starting, err := liquidityAt(ctx, asp, from)
+    if err != nil {
+        return err
+    }
+    ending, err := liquidityAt(ctx, asp, now)
+    if err != nil {
+        return err
+    }
+    buckets, err := flowSeries(ctx, asp, from, now, width)
+    if err != nil {
+        return err
+    }
+
+    var total liquidityFlows
+    level := starting
+    series := make([]FlowStep, 0, len(buckets))
+    for i := range buckets {
+        b := &buckets[i]
+        step := flowStep(max(b.Bucket, from), level, b)
+        step.DisplayDate = time.Unix(b.Bucket, 0).UTC().Format(layout)
+        series = append(series, step)
+        level += b.net()
+        total.add(b)
+    }
+
+    residual := ending - (starting + total.net())
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe":               timeframe,
+        "from":                    from,
+        "to":                      now,
+        "summary":                 flowStep(from, starting, &total),
+        "measuredEndingLiquidity": float64(ending) / 100000000.0,
+        "residual":                float64(residual) / 100000000.0,
+        "balanced":                residual == 0,
+        "series":                  series,
+        "timestamp":               now * 1000,
+    })
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+    "context"
+    "os"
+    "testing"
+    "time"
+)
+
+func TestFlowsCountSweeps(t *testing.T) {
+    dsn := os.Getenv("ARKEXPLORER_TEST_DSN")
+    if dsn == "" {
+        t.Skip("ARKEXPLORER_TEST_DSN not set")
+    }
+    ctx := context.Background()
+    if err := openDB(dsn); err != nil {
+        t.Fatal(err)
+    }
+    defer DB.Close()
+    seedTestData(t, ctx)
+
+    // Past the seeded spends, which are dated now
+    to := time.Now().Unix() + 1
+    buckets, err := flowSeries(ctx, "", 0, to, 24*3600)
+    if err != nil {
+        t.Fatal(err)
+    }
+    var total liquidityFlows
+    for i := range buckets {
+        total.add(&buckets[i])
+    }
+    if total.Sweeps != 20000 {
+        t.Errorf("sweeps %d, want 20000", total.Sweeps)
+    }
+
+    // a1:0 and r3:0 are unspent; the swept r4:0 is not liquidity
+    ending, err := liquidityAt(ctx, "", to)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if ending != 110000 {
+        t.Errorf("liquidity %d, want 110000", ending)
+    }
+    if total.net() != ending {
+        t.Errorf("flows add up to %d, liquidity is %d", total.net(), ending)
+    }
+}
@@ -2,176 +2,439 @@ package main
 
 import (
     "context"
-    "encoding/json"
+    "encoding/base64"
+    "errors"
     "net/http"
+    "strconv"
+    "strings"
     "time"
-    "log"
     "math"
+
+    "github.com/uptrace/bun"
 )
 
-func GetStats(w http.ResponseWriter, r *http.Request) {
+// periodMetrics are the stat fields over a window. NetworkLiquidity is
+// taken at the end of the window.
+type periodMetrics struct {
+	OnboardingVolume  float64 `bun:"onboarding_volume"`
+	OffboardingVolume float64 `bun:"offboarding_volume"`
+	VirtualTxVolume   float64 `bun:"virtual_tx_volume"`
+	VirtualTxCount    int     `bun:"virtual_tx_count"`
+	NetworkLiquidity  float64 `bun:"-"`
+	Scripts           scriptActivity `bun:"-"`
+}
+
+// periodStats computes periodMetrics over [from, to), unix seconds; to 0
+// leaves the window open so VTXOs stamped slightly ahead still count.
+func periodStats(ctx context.Context, asp string, from, to int64) (*periodMetrics, error) {
+	m := new(periodMetrics)
+
+	q := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+		ColumnExpr("COALESCE(SUM(CASE WHEN tx_type = 'onboard' THEN amount END), 0) / 100000000.0 AS onboarding_volume").
+		ColumnExpr("COALESCE(SUM(CASE WHEN tx_type = 'offboard' THEN amount END), 0) / 100000000.0 AS offboarding_volume").
+		ColumnExpr("COALESCE(SUM(CASE WHEN tx_type = 'virtual' THEN amount END), 0) / 100000000.0 AS virtual_tx_volume").
+		ColumnExpr("COUNT(CASE WHEN tx_type = 'virtual' THEN 1 END) AS virtual_tx_count").
+		Where("created_at >= ?", from)
+	if to > 0 {
+		q = q.Where("created_at < ?", to)
+	}
+	if err := q.Scan(ctx, m); err != nil {
+		return nil, err
+	}
+
+	// Liquidity then: VTXOs that existed and were not yet spent
+	liquidity := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+		ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0")
+	if to > 0 {
+		liquidity = liquidity.Where("created_at < ? AND (is_spent = ? OR spent_at >= ?)", to, false, to)
+	} else {
+		liquidity = liquidity.Where("is_spent = ?", false)
+	}
+	if err := liquidity.Scan(ctx, &m.NetworkLiquidity); err != nil {
+		return nil, err
+	}
+
+	scripts, err := scriptActivityBetween(ctx, asp, from, to)
+	if err != nil {
+		return nil, err
+	}
+	m.Scripts = scripts
+	return m, nil
+}
+
+// metricChange compares a stat with the same stat in the comparison window.
+// Previous and Delta are null without a comparison window, Percent also
+// when Previous is zero.
+type metricChange struct {
+	Current  float64  `json:"current"`
+	Previous *float64 `json:"previous"`
+	Delta    *float64 `json:"delta"`
+	Percent  *float64 `json:"percent"`
+}
+
+func calcChange(curr float64, prev *float64) metricChange {
+	c := metricChange{Current: curr, Previous: prev}
+	if prev == nil {
+		return c
+	}
+	delta := curr - *prev
+	c.Delta = &delta
+	if *prev != 0 {
+		percent := math.Round(delta / *prev * 1000) / 10 // 1 decimal place (e.g. 12.5)
+		c.Percent = &percent
+	}
+	return c
+}
+
+func GetStats(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
-	// 1. Parse Timeframe
-	timeframe := r.URL.Query().Get("timeframe")
-	if timeframe == "" {
-		timeframe = "24h"
+	// 1. Parse Timeframe and comparison mode
+	timeframe, err := parseTimeframe(r)
+	if err != nil {
+		return err
 	}
+	asp := r.URL.Query().Get("asp")
 
 	now := time.Now().Unix()
-	var periodSeconds int64
+	periodSeconds := timeframeSeconds(timeframe)
 	isAllTime := timeframe == "all time"
 
-	switch timeframe {
-	case "24h":
-		periodSeconds = 24 * 3600
-	case "1w":
-		periodSeconds = 7 * 24 * 3600
-	case "1month":
-		periodSeconds = 30 * 24 * 3600
-	case "all time":
-		periodSeconds = 0
-	default:
-		periodSeconds = 24 * 3600
-	}
-
-	// Calculate the two time windows
 	currentStart := now - periodSeconds
 	if isAllTime {
 		currentStart = 0
 	}
-	previousStart := currentStart - periodSeconds
 
-	// Data structures
-	type PeriodMetrics struct {
-		Volume float64 `bun:"volume"`
-		Count  int     `bun:"count"`
+	// 2. Comparison window: the period just before, or the same period a
+	// week or month earlier
+	compare := r.URL.Query().Get("compare")
+	var previousStart, previousEnd int64
+	hasPrevious := true
+	switch compare {
+	case "", "previous":
+		compare = "previous"
+		previousStart, previousEnd = currentStart-periodSeconds, currentStart
+		// There is no 'before the beginning' of all time
+		hasPrevious = !isAllTime
+	case "week":
+		previousStart, previousEnd = currentStart-7*86400, now-7*86400
+	case "month":
+		previousStart, previousEnd = currentStart-30*86400, now-30*86400
+	default:
+		return badRequest("compare must be previous, week or month")
+	}
+	if isAllTime {
+		previousStart = 0
 	}
-	var current, previous PeriodMetrics
-	var liquidity, onboardVol, offboardVol float64
 
-	// 2. Current Period Stats (Virtual TXs)
-	// We use a single query to get both SUM and COUNT for efficiency
-	err := DB.NewSelect().Model((*VTXO)(nil)).
-		ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0 AS volume").
-		ColumnExpr("COUNT(*) AS count").
-		Where("tx_type = 'virtual' AND created_at >= ?", currentStart).
-		Scan(ctx, &current)
+	// 3. Stats for both windows
+	current, err := periodStats(ctx, asp, currentStart, 0)
 	if err != nil {
-		log.Printf("Error fetching current stats: %v", err)
-	}
-
-	// 3. Previous Period Stats (For Change Calculation)
-	// We skip this if 'all time' because there is no 'before the beginning'
-	if !isAllTime {
-		DB.NewSelect().Model((*VTXO)(nil)).
-			ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0 AS volume").
-			ColumnExpr("COUNT(*) AS count").
-			Where("tx_type = 'virtual' AND created_at >= ? AND created_at < ?", previousStart, currentStart).
-			Scan(ctx, &previous)
-	}
-
-	// 4. Liquidity & Flows
-	// Liquidity is always the total current unspent supply
-	DB.NewSelect().Model((*VTXO)(nil)).
-		Where("is_spent = ?", false).
-		ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0").
-		Scan(ctx, &liquidity)
-
-	// Flows are tied to the selected timeframe
-	DB.NewSelect().Model((*VTXO)(nil)).
-		Where("tx_type = 'onboard' AND created_at >= ?", currentStart).
-		ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0").
-		Scan(ctx, &onboardVol)
-
-	DB.NewSelect().Model((*VTXO)(nil)).
-		Where("tx_type = 'offboard' AND created_at >= ?", currentStart).
-		ColumnExpr("COALESCE(SUM(amount), 0) / 100000000.0").
-		Scan(ctx, &offboardVol)
-
-	// 5. Math Helper for Percentages
-	calcChange := func(curr, prev float64) float64 {
-		if prev == 0 {
-			if curr > 0 { return 100.0 } // 100% growth if we went from 0 to something
-			return 0
+		return err
+	}
+	var previous *periodMetrics
+	if hasPrevious {
+		if previous, err = periodStats(ctx, asp, previousStart, previousEnd); err != nil {
+			return err
 		}
-		change := ((curr - prev) / prev) * 100
-		return math.Round(change*10) / 10 // Round to 1 decimal place (e.g. 12.5)
+	}
+
+	// 4. Per-metric changes
+	fields := func(m *periodMetrics) map[string]float64 {
+		return map[string]float64{
+			"onboardingVolume":  m.OnboardingVolume,
+			"offboardingVolume": m.OffboardingVolume,
+			"networkLiquidity":  m.NetworkLiquidity,
+			"virtualTxVolume":   m.VirtualTxVolume,
+			"virtualTxCount":    float64(m.VirtualTxCount),
+			"activeScripts":     float64(m.Scripts.Active),
+			"newScripts":        float64(m.Scripts.New),
+			"returningScripts":  float64(m.Scripts.Returning),
+		}
+	}
+	changes := make(map[string]metricChange)
+	var prevFields map[string]float64
+	if previous != nil {
+		prevFields = fields(previous)
+	}
+	for name, curr := range fields(current) {
+		var prev *float64
+		if v, ok := prevFields[name]; ok {
+			prev = &v
+		}
+		changes[name] = calcChange(curr, prev)
+	}
+
+	var comparedWith map[string]int64
+	if hasPrevious {
+		comparedWith = map[string]int64{"from": previousStart, "to": previousEnd}
+	}
+
+	// 5. Per-ASP breakdown of the same figures
+	byASP, err := aspBreakdown(ctx, currentStart)
+	if err != nil {
+		return err
+	}
+	if asp != "" {
+		filtered := make([]ASPMetrics, 0, 1)
+		for _, m := range byASP {
+			if m.Asp == asp {
+				filtered = append(filtered, m)
+			}
+		}
+		byASP = filtered
 	}
 
 	// 6. Final Response
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"onboardingVolume":  onboardVol,
-		"offboardingVolume": offboardVol,
-		"networkLiquidity":  liquidity,
-		"virtualTxCount":    current.Count,
-		"virtualTxVolume":   current.Volume,
-		"txCountChange":     calcChange(float64(current.Count), float64(previous.Count)),
-		"volumeChange":      calcChange(current.Volume, previous.Volume),
+	return writeJSON(w, http.StatusOK, map[string]interface{}{
+		"onboardingVolume":  current.OnboardingVolume,
+		"offboardingVolume": current.OffboardingVolume,
+		"networkLiquidity":  current.NetworkLiquidity,
+		"virtualTxCount":    current.VirtualTxCount,
+		"virtualTxVolume":   current.VirtualTxVolume,
+		"activeScripts":     current.Scripts.Active,
+		"newScripts":        current.Scripts.New,
+		"returningScripts":  current.Scripts.Returning,
+		"txCountChange":     changes["virtualTxCount"].Percent,
+		"volumeChange":      changes["virtualTxVolume"].Percent,
+		"compare":           compare,
+		"comparedWith":      comparedWith,
+		"changes":           changes,
 		"timeframe":         timeframe,
+		"asps":              byASP,
 		"timestamp":         now * 1000, // Frontend expects milliseconds
 	})
 }
 
-func GetRecentTxs(w http.ResponseWriter, r *http.Request) {
-    ctx := context.Background()
+func GetRecentTxs(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
 
-    var results []struct {
+    results := make([]struct {
         Txid      string `bun:"txid" json:"txid"`
         CreatedAt int64  `bun:"created_at" json:"createdAt"`
         TxType    string `bun:"tx_type" json:"txType"`
-    }
+        Asp       string `bun:"asp" json:"asp"`
+    }, 0)
 
-    err := DB.NewSelect().
-        Model((*VTXO)(nil)).
-        ColumnExpr("DISTINCT txid, created_at, tx_type").
+    err := filterASP(DB.NewSelect().Model((*VTXO)(nil)), r).
+        ColumnExpr("DISTINCT txid, created_at, tx_type, asp").
         Order("created_at DESC").
         Limit(10).
         Scan(ctx, &results)
 
     if err != nil {
-        http.Error(w, err.Error(), http.StatusInternalServerError)
-        return
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, results)
+}
+
+// GetTransactions pages through transactions newest first using keyset
+// pagination on (created_at, txid). Pass nextCursor back as ?cursor= to
+// continue.
+//
+// A transaction is built from the VTXOs it created (txid) and those it spent
+// (spent_by), so offboards that create nothing are listed too. Its type is
+// the one stored on its non-swept inputs, which the ingester overwrites with
+// the spender's type; without such inputs it spent only swept VTXOs
+// (a refresh) or nothing (boarding).
+func GetTransactions(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+    query := r.URL.Query()
+
+    limit := 50
+    if v := query.Get("limit"); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n <= 0 {
+            return badRequest("limit must be a positive integer")
+        }
+        limit = min(n, 500)
+    }
+
+    from, err := int64Param(r, "from")
+    if err != nil {
+        return err
+    }
+    to, err := int64Param(r, "to")
+    if err != nil {
+        return err
+    }
+    minAmount, err := int64Param(r, "minAmount")
+    if err != nil {
+        return err
+    }
+    maxAmount, err := int64Param(r, "maxAmount")
+    if err != nil {
+        return err
+    }
+
+    type TxRow struct {
+        Txid      string `bun:"txid" json:"txid"`
+        CreatedAt int64  `bun:"created_at" json:"createdAt"`
+        TxType    string `bun:"tx_type" json:"txType"`
+        Asp       string `bun:"asp" json:"asp"`
+        Amount      int64  `bun:"amount" json:"amount"`
+        VtxoCount   int    `bun:"vtxo_count" json:"vtxoCount"`
+        InputAmount int64  `bun:"input_amount" json:"inputAmount"`
+        InputCount  int    `bun:"input_count" json:"inputCount"`
+    }
+
+    var cursorAt int64
+    var cursorTxid string
+    if c := query.Get("cursor"); c != "" {
+        if cursorAt, cursorTxid, err = decodeTxCursor(c); err != nil {
+            return badRequest("invalid cursor")
+        }
+    }
+    // Both legs of a transaction are dated and keyed by it, so the time
+    // filters and the cursor can be applied to each leg before grouping
+    legs := func(q *bun.SelectQuery, at, tx string) *bun.SelectQuery {
+        q = filterASP(q, r)
+        if from > 0 {
+            q = q.Where(at+" >= ?", from)
+        }
+        if to > 0 {
+            q = q.Where(at+" < ?", to)
+        }
+        if cursorTxid != "" {
+            q = q.Where("("+at+" < ? OR ("+at+" = ? AND "+tx+" < ?))", cursorAt, cursorAt, cursorTxid)
+        }
+        return q
+    }
+    outputs := legs(DB.NewSelect().Model((*VTXO)(nil)), "created_at", "txid").
+        ColumnExpr("txid AS tx, created_at AS at, asp").
+        ColumnExpr("amount AS output_amount, 1 AS output_count, 0 AS input_amount, 0 AS input_count").
+        ColumnExpr("NULL AS input_type, 0 AS swept_input")
+    inputs := legs(DB.NewSelect().Model((*VTXO)(nil)), "spent_at", "spent_by").
+        ColumnExpr("spent_by, spent_at, asp").
+        ColumnExpr("0, 0, amount, 1").
+        ColumnExpr("CASE WHEN is_swept THEN NULL ELSE tx_type END, CASE WHEN is_swept THEN 1 ELSE 0 END").
+        Where("is_spent = ? AND spent_by <> ''", true)
+
+    q := DB.NewSelect().
+        TableExpr("(?) AS legs", outputs.UnionAll(inputs)).
+        ColumnExpr("tx AS txid, MAX(at) AS created_at, MAX(asp) AS asp").
+        ColumnExpr("COALESCE(MAX(input_type), CASE WHEN MAX(swept_input) = 1 THEN 'refresh' ELSE 'onboard' END) AS tx_type").
+        ColumnExpr("SUM(output_amount) AS amount, SUM(output_count) AS vtxo_count").
+        ColumnExpr("SUM(input_amount) AS input_amount, SUM(input_count) AS input_count").
+        GroupExpr("tx")
+
+    if types := splitList(query.Get("type")); len(types) > 0 {
+        q = q.Having("tx_type IN (?)", bun.In(types))
+    }
+    // Offboards create nothing, so they are sized by what they spend
+    if minAmount > 0 {
+        q = q.Having("GREATEST(amount, input_amount) >= ?", minAmount)
+    }
+    if maxAmount > 0 {
+        q = q.Having("GREATEST(amount, input_amount) <= ?", maxAmount)
+    }
+
+    results := make([]TxRow, 0, limit+1)
+    err = q.
+        OrderExpr("created_at DESC, txid DESC").
+        Limit(limit + 1).
+        Scan(ctx, &results)
+    if err != nil {
+        return err
     }
 
-    w.Header().Set("Content-Type", "application/json")
-    json.NewEncoder(w).Encode(results)
+    // One extra row tells us whether another page exists
+    var nextCursor *string
+    if len(results) > limit {
+        results = results[:limit]
+        last := results[limit-1]
+        c := encodeTxCursor(last.CreatedAt, last.Txid)
+        nextCursor = &c
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "transactions": results,
+        "nextCursor":   nextCursor,
+    })
+}
+
+func encodeTxCursor(createdAt int64, txid string) string {
+    return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(createdAt, 10) + ":" + txid))
 }
 
-func SearchTx(w http.ResponseWriter, r *http.Request) {
-    txid := r.URL.Query().Get("txid")
+func decodeTxCursor(cursor string) (int64, string, error) {
+    raw, err := base64.RawURLEncoding.DecodeString(cursor)
+    if err != nil {
+        return 0, "", err
+    }
+    ts, txid, ok := strings.Cut(string(raw), ":")
+    if !ok || txid == "" {
+        return 0, "", errors.New("malformed cursor")
+    }
+    createdAt, err := strconv.ParseInt(ts, 10, 64)
+    return createdAt, txid, err
+}
+
+func SearchTx(w http.ResponseWriter, r *http.Request) error {
+    txid := r.PathValue("txid")
+    if txid == "" {
+        txid = r.URL.Query().Get("txid")
+    }
     if txid == "" {
-        w.WriteHeader(http.StatusBadRequest)
-        json.NewEncoder(w).Encode(map[string]string{"error": "txid required"})
-        return
+        return badRequest("txid required")
     }
     
     var vtxos []VTXO
-    ctx := context.Background()
-    DB.NewSelect().Model(&vtxos).Where("txid = ?", txid).Scan(ctx, &vtxos)
+    ctx := r.Context()
+    if err := filterASP(DB.NewSelect().Model(&vtxos), r).Where("txid = ?", txid).Scan(ctx); err != nil {
+        return err
+    }
+    if len(vtxos) == 0 {
+        return notFound("transaction %s not found", txid)
+    }
     
-    w.Header().Set("Content-Type", "application/json")
-    json.NewEncoder(w).Encode(vtxos)
+    return writeJSON(w, http.StatusOK, vtxos)
 }
 
-func GetNetworkTrends(w http.ResponseWriter, r *http.Request) {
+type TrendPoint struct {
+    DisplayDate       string  `json:"displayDate" bun:"display_date" parquet:"display_date"`
+    OnboardingVolume  float64 `json:"onboardingVolume" bun:"onboarding_volume" parquet:"onboarding_volume"`
+    OffboardingVolume float64 `json:"offboardingVolume" bun:"offboarding_volume" parquet:"offboarding_volume"`
+    VirtualTxVolume   float64 `json:"virtualTxVolume" bun:"virtual_tx_volume" parquet:"virtual_tx_volume"`
+    VirtualTxCount    int     `json:"virtualTxCount" bun:"virtual_tx_count" parquet:"virtual_tx_count"`
+    ActiveScripts     int     `json:"activeScripts" bun:"-" parquet:"active_scripts"`
+    NewScripts        int     `json:"newScripts" bun:"-" parquet:"new_scripts"`
+    ReturningScripts  int     `json:"returningScripts" bun:"-" parquet:"returning_scripts"`
+    RefreshCount      int     `json:"refreshCount" bun:"-" parquet:"refresh_count"`
+    RefreshVolume     float64 `json:"refreshVolume" bun:"-" parquet:"refresh_volume"`
+    Asp               string  `json:"asp,omitempty" bun:"asp" parquet:"asp"`
+}
+
+func GetNetworkTrends(w http.ResponseWriter, r *http.Request) error {
     ctx := r.Context()
     
-    timeframe := r.URL.Query().Get("timeframe")
-    if timeframe == "" { timeframe = "24h" }
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
     
+    history, err := queryTrends(ctx, timeframe, r.URL.Query().Get("asp"), r.URL.Query().Get("breakdown") == "asp")
+    if err != nil {
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, history)
+}
+
+// queryTrends buckets flows by hour (24h) or day. With byASP set, each bucket
+// is split into one point per ASP.
+func queryTrends(ctx context.Context, timeframe, asp string, byASP bool) ([]TrendPoint, error) {
     now := time.Now().Unix()
     var periodStartSeconds int64
     var limit int
     
-    dateFormat := "DATE(FROM_UNIXTIME(created_at))"
+    dateFormat := bucketDateFormat(timeframe)
 
     switch timeframe {
     case "24h":
         periodStartSeconds = now - (24 * 3600)
         limit = 24
-        dateFormat = "DATE_FORMAT(FROM_UNIXTIME(created_at), '%Y-%m-%d %H:00')"
     case "1w":
         periodStartSeconds = now - (7 * 24 * 3600)
         limit = 7
@@ -186,36 +449,66 @@ func GetNetworkTrends(w http.ResponseWriter, r *http.Request) {
         limit = 30
     }
 
-    type TrendPoint struct {
-        DisplayDate       string  `json:"displayDate" bun:"display_date"`
-        OnboardingVolume  float64 `json:"onboardingVolume" bun:"onboarding_volume"`
-        OffboardingVolume float64 `json:"offboardingVolume" bun:"offboarding_volume"`
-        VirtualTxVolume   float64 `json:"virtualTxVolume" bun:"virtual_tx_volume"`
-        VirtualTxCount    int     `json:"virtualTxCount" bun:"virtual_tx_count"`
-    }
-
     history := make([]TrendPoint, 0)
 
-    err := DB.NewSelect().
-        Model((*VTXO)(nil)).
+    groupBy := []string{"display_date"}
+    q := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp)
+    if byASP {
+        q = q.ColumnExpr("asp")
+        groupBy = append(groupBy, "asp")
+        if n := len(knownASPs(ctx)); n > 1 {
+            limit *= n
+        }
+    }
+
+    err := q.
         ColumnExpr(dateFormat + " AS display_date").
         ColumnExpr("SUM(CASE WHEN tx_type = 'onboard' THEN amount ELSE 0 END) / 100000000.0 AS onboarding_volume").
         ColumnExpr("SUM(CASE WHEN tx_type = 'offboard' THEN amount ELSE 0 END) / 100000000.0 AS offboarding_volume").
         ColumnExpr("SUM(CASE WHEN tx_type = 'virtual' THEN amount ELSE 0 END) / 100000000.0 AS virtual_tx_volume").
         ColumnExpr("COUNT(CASE WHEN tx_type = 'virtual' THEN 1 END) AS virtual_tx_count").
         Where("created_at >= ?", periodStartSeconds).
-        Group("display_date").
-        Order("display_date ASC").
+        Group(groupBy...).
+        Order(groupBy...).
         Limit(limit).
         Scan(ctx, &history)
+    if err != nil {
+        return nil, err
+    }
 
+    activity, err := trendScriptActivity(ctx, dateFormat, periodStartSeconds, asp, byASP)
     if err != nil {
-        log.Printf("SQL Error: %v", err)
-        w.Header().Set("Content-Type", "application/json")
-        json.NewEncoder(w).Encode([]TrendPoint{})
-        return
+        return nil, err
+    }
+    refreshes, err := refreshSeries(ctx, dateFormat, periodStartSeconds, asp, byASP)
+    if err != nil {
+        return nil, err
+    }
+    refreshByKey := make(map[string]refreshMetrics, len(refreshes))
+    for _, m := range refreshes {
+        refreshByKey[m.DisplayDate+"|"+m.Asp] = m
     }
 
-    w.Header().Set("Content-Type", "application/json")
-    json.NewEncoder(w).Encode(history)
+    for i := range history {
+        p := &history[i]
+        key := p.DisplayDate + "|" + p.Asp
+        a := activity[key]
+        p.ActiveScripts, p.NewScripts, p.ReturningScripts = a.Active, a.New, a.Returning
+        p.RefreshCount, p.RefreshVolume = refreshByKey[key].RefreshCount, refreshByKey[key].RefreshVolume
+    }
+    return history, nil
+}
+
+
+// int64Param parses an optional integer query parameter; missing means 0.
+func int64Param(r *http.Request, name string) (int64, error) {
+    v := r.URL.Query().Get(name)
+    if v == "" {
+        return 0, nil
+    }
+    n, err := strconv.ParseInt(v, 10, 64)
+    if err != nil {
+        return 0, badRequest("%s must be an integer", name)
+    }
+    return n, nil
 }
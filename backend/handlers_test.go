@@ -0,0 +1,113 @@
+package main
+
+import (
+    "context"
+    "encoding/base64"
+    "encoding/json"
+    "net/http/httptest"
+    "os"
+    "testing"
+)
+
+func TestTxCursor(t *testing.T) {
+    at, txid, err := decodeTxCursor(encodeTxCursor(1700000000, "abc:def"))
+    if err != nil || at != 1700000000 || txid != "abc:def" {
+        t.Errorf("round trip = %d, %q, %v", at, txid, err)
+    }
+
+    for _, cursor := range []string{
+        "",
+        "not base64!",
+        base64.RawURLEncoding.EncodeToString([]byte("1700000000")),
+        base64.RawURLEncoding.EncodeToString([]byte("1700000000:")),
+        base64.RawURLEncoding.EncodeToString([]byte("soon:abc")),
+    } {
+        if _, _, err := decodeTxCursor(cursor); err == nil {
+            t.Errorf("decodeTxCursor(%q) accepted", cursor)
+        }
+    }
+}
+
+func TestCalcChange(t *testing.T) {
+    ptr := func(v float64) *float64 { return &v }
+    tests := []struct {
+        name           string
+        curr           float64
+        prev           *float64
+        delta, percent *float64
+    }{
+        {"no comparison", 5, nil, nil, nil},
+        {"from zero", 5, ptr(0), ptr(5), nil},
+        {"growth", 12, ptr(8), ptr(4), ptr(50)},
+        {"rounded to a tenth", 10, ptr(3), ptr(7), ptr(233.3)},
+        {"decline", 6, ptr(8), ptr(-2), ptr(-25)},
+    }
+    equal := func(a, b *float64) bool { return a == nil && b == nil || a != nil && b != nil && *a == *b }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            c := calcChange(tt.curr, tt.prev)
+            if c.Current != tt.curr || c.Previous != tt.prev || !equal(c.Delta, tt.delta) || !equal(c.Percent, tt.percent) {
+                t.Errorf("calcChange(%v) = %+v", tt.curr, c)
+            }
+        })
+    }
+}
+
+func TestTransactionsPaginate(t *testing.T) {
+    dsn := os.Getenv("ARKEXPLORER_TEST_DSN")
+    if dsn == "" {
+        t.Skip("ARKEXPLORER_TEST_DSN not set")
+    }
+    ctx := context.Background()
+    if err := openDB(dsn); err != nil {
+        t.Fatal(err)
+    }
+    defer DB.Close()
+    seedTestData(t, ctx)
+
+    page := func(path string) (txids []string, cursor string) {
+        rec := httptest.NewRecorder()
+        if err := GetTransactions(rec, httptest.NewRequest("GET", path, nil)); err != nil {
+            t.Fatal(err)
+        }
+        var body struct {
+            Transactions []struct {
+                Txid string `json:"txid"`
+            } `json:"transactions"`
+            NextCursor *string `json:"nextCursor"`
+        }
+        if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+            t.Fatal(err)
+        }
+        for _, tx := range body.Transactions {
+            txids = append(txids, tx.Txid)
+        }
+        if body.NextCursor != nil {
+            cursor = *body.NextCursor
+        }
+        return txids, cursor
+    }
+
+    all, _ := page("/api/transactions?limit=500")
+    var paged []string
+    cursor := ""
+    for i := 0; i <= len(all); i++ {
+        path := "/api/transactions?limit=1"
+        if cursor != "" {
+            path += "&cursor=" + cursor
+        }
+        txids, next := page(path)
+        paged = append(paged, txids...)
+        if cursor = next; cursor == "" {
+            break
+        }
+    }
+    if len(paged) != len(all) {
+        t.Fatalf("paged %v, want %v", paged, all)
+    }
+    for i := range all {
+        if paged[i] != all[i] {
+            t.Fatalf("paged %v, want %v", paged, all)
+        }
+    }
+}
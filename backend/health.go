@@ -0,0 +1,135 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "sort"
+    "sync"
+    "time"
+)
+
+// Readiness thresholds, set from flags in main.
+var (
+    readyMaxDisconnect = 2 * time.Minute
+    readyMaxEventAge   = 30 * time.Minute
+)
+
+type streamStatus struct {
+    Connected bool
+    Since     time.Time // when Connected last changed
+}
+
+// streamStates tracks the connection state of each ASP stream.
+var streamStates sync.Map // asp -> streamStatus
+
+func setStreamConnected(asp string, connected bool) {
+    if prev, ok := streamStates.Load(asp); ok && prev.(streamStatus).Connected == connected {
+        return
+    }
+    streamStates.Store(asp, streamStatus{Connected: connected, Since: time.Now()})
+}
+
+// Healthz is the liveness probe: the process is up and serving HTTP.
+func Healthz(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Content-Type", "application/json")
+    json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// Readyz fails when the database is unreachable, a stream has been
+// disconnected for longer than readyMaxDisconnect, or the newest stored event
+// of an ASP is older than readyMaxEventAge.
+func Readyz(w http.ResponseWriter, r *http.Request) {
+    ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+    defer cancel()
+
+    ready := true
+    now := time.Now()
+
+    type dbCheck struct {
+        OK        bool   `json:"ok"`
+        LatencyMs int64  `json:"latencyMs"`
+        Error     string `json:"error,omitempty"`
+    }
+    start := time.Now()
+    db := dbCheck{OK: true}
+    if err := DB.PingContext(ctx); err != nil {
+        db.OK, db.Error = false, err.Error()
+        ready = false
+    }
+    db.LatencyMs = time.Since(start).Milliseconds()
+
+    // Newest stored event per ASP; idx_events_asp_ts keeps this to one index
+    // lookup per ASP
+    lastStored := make(map[string]int64)
+    if db.OK {
+        var rows []struct {
+            Asp    string `bun:"asp"`
+            Latest int64  `bun:"latest"`
+        }
+        err := DB.NewSelect().
+            Model((*Events)(nil)).
+            ColumnExpr("asp, MAX(timestamp_ms) AS latest").
+            Group("asp").
+            Scan(ctx, &rows)
+        if err != nil {
+            db.OK, db.Error = false, err.Error()
+            ready = false
+        }
+        for _, row := range rows {
+            lastStored[row.Asp] = row.Latest
+        }
+    }
+
+    type streamCheck struct {
+        Asp                string  `json:"asp"`
+        OK                 bool    `json:"ok"`
+        Connected          bool    `json:"connected"`
+        DisconnectedFor    float64 `json:"disconnectedForSeconds,omitempty"`
+        LastEventAge       float64 `json:"lastEventAgeSeconds"`
+        LastStoredEventAge float64 `json:"lastStoredEventAgeSeconds,omitempty"`
+        Reason             string  `json:"reason,omitempty"`
+    }
+    streams := make([]streamCheck, 0)
+    streamStates.Range(func(key, value interface{}) bool {
+        asp, state := key.(string), value.(streamStatus)
+        check := streamCheck{Asp: asp, OK: true, Connected: state.Connected}
+
+        if t, ok := lastEventAt.Load(asp); ok {
+            check.LastEventAge = now.Sub(t.(time.Time)).Seconds()
+        }
+        if !state.Connected {
+            down := now.Sub(state.Since)
+            check.DisconnectedFor = down.Seconds()
+            if down > readyMaxDisconnect {
+                check.OK, check.Reason = false, "stream disconnected"
+            }
+        }
+        if ts, ok := lastStored[asp]; ok {
+            age := now.Sub(time.UnixMilli(ts))
+            check.LastStoredEventAge = age.Seconds()
+            if readyMaxEventAge > 0 && age > readyMaxEventAge && check.OK {
+                check.OK, check.Reason = false, "no recent events"
+            }
+        }
+
+        if !check.OK {
+            ready = false
+        }
+        streams = append(streams, check)
+        return true
+    })
+    sort.Slice(streams, func(i, j int) bool { return streams[i].Asp < streams[j].Asp })
+
+    status := "ok"
+    w.Header().Set("Content-Type", "application/json")
+    if !ready {
+        status = "unavailable"
+        w.WriteHeader(http.StatusServiceUnavailable)
+    }
+    json.NewEncoder(w).Encode(map[string]interface{}{
+        "status":   status,
+        "database": db,
+        "streams":  streams,
+    })
+}
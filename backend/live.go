@@ -0,0 +1,205 @@
+package main
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "log"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+)
+
+// liveClientBuffer is how many events a subscriber may fall behind before it
+// is disconnected.
+const liveClientBuffer = 64
+
+// LiveVTXO is the per-output part of a live transaction event.
+type LiveVTXO struct {
+    Txid      string `json:"txid"`
+    Vout      int    `json:"vout"`
+    Amount    int64  `json:"amount"`
+    Script    string `json:"script"`
+    ExpiresAt int64  `json:"expiresAt"`
+    IsSwept   bool   `json:"isSwept"`
+}
+
+// LiveTx is the normalized transaction event pushed to /api/live subscribers.
+type LiveTx struct {
+    Txid         string     `json:"txid"`
+    Asp          string     `json:"asp"`
+    TxType       string     `json:"txType"`
+    IsCommitment bool       `json:"isCommitment"`
+    CreatedAt    int64      `json:"createdAt"`
+    Inputs       []LiveVTXO `json:"inputs"`
+    Outputs      []LiveVTXO `json:"outputs"`
+}
+
+type liveEvent struct {
+    name string
+    tx   *LiveTx
+    data []byte
+}
+
+type liveClient struct {
+    events  chan liveEvent
+    types   map[string]bool
+    scripts map[string]bool
+    asp     string
+    evicted chan struct{}
+}
+
+func (c *liveClient) wants(e liveEvent) bool {
+    if e.tx == nil {
+        return true
+    }
+    if c.asp != "" && e.tx.Asp != c.asp {
+        return false
+    }
+    if len(c.types) > 0 && !c.types[e.tx.TxType] {
+        return false
+    }
+    if len(c.scripts) > 0 {
+        for _, v := range e.tx.Inputs {
+            if c.scripts[v.Script] {
+                return true
+            }
+        }
+        for _, v := range e.tx.Outputs {
+            if c.scripts[v.Script] {
+                return true
+            }
+        }
+        return false
+    }
+    return true
+}
+
+// liveHub fans ingested transactions and stat updates out to SSE subscribers.
+var liveHub = struct {
+    sync.Mutex
+    clients map[*liveClient]bool
+}{clients: make(map[*liveClient]bool)}
+
+// liveShutdown is closed when the server shuts down so open streams end and
+// do not hold up http.Server.Shutdown.
+var liveShutdown = make(chan struct{})
+
+func closeLiveClients() {
+    close(liveShutdown)
+}
+
+func publishLive(name string, tx *LiveTx, payload interface{}) {
+    data, err := json.Marshal(payload)
+    if err != nil {
+        log.Printf("Error encoding live %s event: %v", name, err)
+        return
+    }
+    e := liveEvent{name: name, tx: tx, data: data}
+
+    liveHub.Lock()
+    defer liveHub.Unlock()
+    for c := range liveHub.clients {
+        if !c.wants(e) {
+            continue
+        }
+        select {
+        case c.events <- e:
+        default:
+            // Slow client: drop it rather than block ingestion
+            delete(liveHub.clients, c)
+            close(c.evicted)
+        }
+    }
+}
+
+// PublishLiveTx pushes a processed transaction to live subscribers.
+func PublishLiveTx(tx *LiveTx) {
+    publishLive("transaction", tx, tx)
+}
+
+// PublishLiveStats pushes a stats snapshot to live subscribers.
+func PublishLiveStats(stats interface{}) {
+    publishLive("stats", nil, stats)
+}
+
+// GetLive streams transaction and stats events as Server-Sent Events.
+// Filters: type (comma separated tx types), address or script (comma
+// separated), asp.
+func GetLive(w http.ResponseWriter, r *http.Request) error {
+    flusher, ok := w.(http.Flusher)
+    if !ok {
+        return errors.New("streaming unsupported by response writer")
+    }
+
+    query := r.URL.Query()
+    client := &liveClient{
+        events:  make(chan liveEvent, liveClientBuffer),
+        types:   make(map[string]bool),
+        scripts: make(map[string]bool),
+        asp:     query.Get("asp"),
+        evicted: make(chan struct{}),
+    }
+    for _, t := range splitList(query.Get("type")) {
+        client.types[t] = true
+    }
+    for _, s := range splitList(query.Get("script")) {
+        client.scripts[strings.ToLower(s)] = true
+    }
+    for _, a := range splitList(query.Get("address")) {
+        script, err := arkAddressScript(a)
+        if err != nil {
+            return badRequest("invalid address %s: %v", a, err)
+        }
+        client.scripts[script] = true
+    }
+
+    liveHub.Lock()
+    liveHub.clients[client] = true
+    liveHub.Unlock()
+    defer func() {
+        liveHub.Lock()
+        delete(liveHub.clients, client)
+        liveHub.Unlock()
+    }()
+
+    w.Header().Set("Content-Type", "text/event-stream")
+    w.Header().Set("Cache-Control", "no-cache")
+    w.Header().Set("Connection", "keep-alive")
+    w.Header().Set("X-Accel-Buffering", "no")
+    fmt.Fprint(w, "retry: 5000\n\n")
+    flusher.Flush()
+
+    heartbeat := time.NewTicker(30 * time.Second)
+    defer heartbeat.Stop()
+
+    for {
+        select {
+        case <-r.Context().Done():
+            return nil
+        case <-liveShutdown:
+            return nil
+        case <-client.evicted:
+            fmt.Fprint(w, "event: evicted\ndata: {\"reason\":\"slow client\"}\n\n")
+            flusher.Flush()
+            return nil
+        case <-heartbeat.C:
+            fmt.Fprint(w, ": heartbeat\n\n")
+            flusher.Flush()
+        case e := <-client.events:
+            fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.name, e.data)
+            flusher.Flush()
+        }
+    }
+}
+
+func splitList(s string) []string {
+    var out []string
+    for _, part := range strings.Split(s, ",") {
+        if part = strings.TrimSpace(part); part != "" {
+            out = append(out, part)
+        }
+    }
+    return out
+}
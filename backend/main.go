@@ -6,38 +6,58 @@ import (
     "fmt"
     "log"
     "net/http"
+    "os"
+    "os/signal"
+    "syscall"
+    "time"
 )
 
-func enableCORS(next http.HandlerFunc) http.HandlerFunc {
-    return func(w http.ResponseWriter, r *http.Request) {
-        w.Header().Set("Access-Control-Allow-Origin", "*")
-        w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-        w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-
-        if r.Method == "OPTIONS" {
-            w.WriteHeader(http.StatusOK)
-            return
+func main() {
+    // Subcommands have their own flags
+    if len(os.Args) > 1 {
+        switch os.Args[1] {
+        case "export":
+            os.Exit(runExport(os.Args[2:]))
+        case "apikey":
+            os.Exit(runAPIKeys(os.Args[2:]))
+        case "verify":
+            os.Exit(runVerify(os.Args[2:]))
+        case "optout":
+            os.Exit(runOptOut(os.Args[2:]))
         }
-
-        next(w, r)
     }
-}
 
-func main() {
     backfill := flag.Bool("backfill", false, "Backfill events from database")
     backfillSwept := flag.Bool("backfill-swept", false, "Backfill swept VTXOs to mark as offboard")
     enableCors := flag.Bool("enable-cors", false, "Enable CORS for API endpoints")
+    accessLog := flag.Bool("access-log", false, "Log every HTTP request")
+    flag.Float64Var(&anonRate, "rate-limit", anonRate, "Requests per second allowed per IP without an API key")
+    flag.IntVar(&anonBurst, "rate-burst", anonBurst, "Burst size per IP without an API key")
+    flag.BoolVar(&trustForwarded, "trust-forwarded-for", false, "Rate limit by the first X-Forwarded-For address (only behind a trusted proxy)")
+    flag.DurationVar(&readyMaxDisconnect, "ready-max-disconnect", readyMaxDisconnect, "Fail /readyz when a stream has been disconnected this long")
+    flag.DurationVar(&readyMaxEventAge, "ready-max-event-age", readyMaxEventAge, "Fail /readyz when an ASP's newest stored event is older than this (0 disables)")
+    var asps aspFlag
+    flag.Float64Var(&alertZScore, "alert-zscore", alertZScore, "Raise an alert when a metric is this many standard deviations from its baseline")
+    flag.StringVar(&alertWebhookURL, "alert-webhook", "", "URL to POST alerts to as JSON")
+    flag.DurationVar(&alertQuietAfter, "alert-quiet", alertQuietAfter, "Raise an alert when a stream has delivered no events this long (0 disables)")
+    flag.Var(&asps, "asp", "ASP to ingest as id=url (repeatable, default "+defaultASP+"=https://arkade.computer/v1/txs)")
     flag.Parse()
 
+    if len(asps) == 0 {
+        asps = aspFlag{{ID: defaultASP, URL: "https://arkade.computer/v1/txs"}}
+    }
+
     if err := InitDB(); err != nil {
         log.Fatal(err)
     }
 
     ctx := context.Background()
 
-    DB.NewCreateTable().Model((*Events)(nil)).IfNotExists().Exec(ctx)
-    DB.NewCreateTable().Model((*VTXO)(nil)).IfNotExists().Exec(ctx)
-    DB.NewCreateTable().Model((*NetworkStats)(nil)).IfNotExists().Exec(ctx)
+    createTables(ctx)
+
+    if err := MigrateSchema(ctx); err != nil {
+        log.Fatalf("Schema migration failed: %v", err)
+    }
 
     fmt.Println("Successfully connected to database!")
 
@@ -51,23 +71,65 @@ func main() {
         return // Exit after backfill
     }
 
+    if err := loadWatches(ctx); err != nil {
+        log.Printf("Error loading watches: %v", err)
+    }
+    if err := loadAPIKeys(ctx); err != nil {
+        log.Printf("Error loading API keys: %v", err)
+    }
+
+    // Root context for background workers, cancelled on SIGINT/SIGTERM
+    runCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+    defer stop()
+
     // Start background jobs
-    go ConsumeSSEStream("https://arkade.computer/v1/txs")
-    go StartStatsUpdater()
-
-    // Setup HTTP routes
-    if *enableCors {
-        http.HandleFunc("/api/stats", enableCORS(GetStats))
-        http.HandleFunc("/api/recent-transactions", enableCORS(GetRecentTxs))
-        http.HandleFunc("/api/search", enableCORS(SearchTx))
-        http.HandleFunc("/api/trends", enableCORS(GetNetworkTrends)) 
-    } else {
-        http.HandleFunc("/api/stats", GetStats)
-        http.HandleFunc("/api/recent-transactions", GetRecentTxs)
-        http.HandleFunc("/api/search", SearchTx)
-        http.HandleFunc("/api/trends", GetNetworkTrends)
+    for _, asp := range asps {
+        log.Printf("Ingesting ASP %s from %s", asp.ID, asp.URL)
+        registerStreamMetrics(asp.ID)
+        setStreamConnected(asp.ID, false)
+        supervise(runCtx, "stream-"+asp.ID, func(ctx context.Context) {
+            ConsumeSSEStream(ctx, asp.ID, asp.URL)
+        })
+    }
+    supervise(runCtx, "stats-updater", StartStatsUpdater)
+    supervise(runCtx, "concentration-updater", StartConcentrationUpdater)
+    supervise(runCtx, "anomaly-detector", StartAnomalyDetector)
+    supervise(runCtx, "webhook-dispatcher", StartWebhookDispatcher)
+    supervise(runCtx, "rate-limit-maintenance", StartRateLimitMaintenance)
+
+    server := &http.Server{
+        Addr:    ":8082",
+        Handler: newRouter(routerOptions{CORS: *enableCors, AccessLog: *accessLog}),
+    }
+    server.RegisterOnShutdown(closeLiveClients)
+    go func() {
+        fmt.Println("Server starting on :8082...")
+        if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+            log.Fatal(err)
+        }
+    }()
+
+    <-runCtx.Done()
+    stop()
+    log.Println("Shutting down...")
+
+    // Stop accepting requests and let in-flight ones finish
+    shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+    defer cancel()
+    if err := server.Shutdown(shutdownCtx); err != nil {
+        log.Printf("HTTP shutdown: %v", err)
+    }
+
+    // Workers exit on the cancelled context; then drain events already read
+    if !waitTimeout(&workers, 10*time.Second) {
+        log.Println("Timed out waiting for background workers")
+    }
+    if !waitTimeout(&inflightEvents, 30*time.Second) {
+        log.Println("Timed out draining in-flight events")
     }
 
-    fmt.Println("Server starting on :8082...")
-    log.Fatal(http.ListenAndServe(":8082", nil))
+    log.Println("Flushing final stats...")
+    updateNetworkStats()
+    DB.Close()
+    log.Println("Shutdown complete")
 }
\ No newline at end of file
@@ -0,0 +1,142 @@
+package main
+
+import (
+    "context"
+    "net/http"
+    "strconv"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus"
+    "github.com/prometheus/client_golang/prometheus/promauto"
+    "github.com/uptrace/bun"
+)
+
+var (
+    sseEventsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_sse_events_received_total",
+        Help: "Data lines received from an ASP stream, heartbeats included.",
+    }, []string{"asp"})
+
+    sseEventsParsed = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_sse_events_parsed_total",
+        Help: "Ark or commitment transactions parsed and stored.",
+    }, []string{"asp"})
+
+    parseFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_parse_failures_total",
+        Help: "Events that could not be parsed, by reason.",
+    }, []string{"asp", "reason"})
+
+    eventStoreFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_event_store_failures_total",
+        Help: "Raw events that could not be written to the events table.",
+    }, []string{"asp"})
+
+    sseReconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_sse_reconnects_total",
+        Help: "Reconnects to an ASP stream after a read or connect error.",
+    }, []string{"asp"})
+
+    vtxoUpserts = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_vtxo_upserts_total",
+        Help: "VTXO rows written by the ingester, by spent state.",
+    }, []string{"asp", "state"})
+
+    dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+        Name:    "arkexplorer_db_query_duration_seconds",
+        Help:    "Database query latency by calling handler.",
+        Buckets: prometheus.DefBuckets,
+    }, []string{"handler"})
+
+    httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+        Name:    "arkexplorer_http_request_duration_seconds",
+        Help:    "HTTP request latency by route and status.",
+        Buckets: prometheus.DefBuckets,
+    }, []string{"route", "method", "status"})
+
+    networkLiquidityGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+        Name: "arkexplorer_network_liquidity_sats",
+        Help: "Sum of unspent VTXO amounts, set by the stats updater.",
+    }, []string{"asp"})
+
+    unspentVTXOGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+        Name: "arkexplorer_unspent_vtxos",
+        Help: "Number of unspent VTXOs, set by the stats updater.",
+    }, []string{"asp"})
+
+    responseCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+        Name: "arkexplorer_response_cache_lookups_total",
+        Help: "Aggregate endpoint cache lookups, by hit or miss.",
+    }, []string{"result"})
+)
+
+// lastEventAt records when each ASP stream last delivered a data line.
+var lastEventAt sync.Map // asp -> time.Time
+
+func markEventReceived(asp string) {
+    sseEventsReceived.WithLabelValues(asp).Inc()
+    lastEventAt.Store(asp, time.Now())
+}
+
+// registerStreamMetrics exposes the time since the last event of an ASP, so
+// a silently stalled stream can be alerted on.
+func registerStreamMetrics(asp string) {
+    lastEventAt.LoadOrStore(asp, time.Now())
+    promauto.NewGaugeFunc(prometheus.GaugeOpts{
+        Name:        "arkexplorer_seconds_since_last_event",
+        Help:        "Seconds since the ASP stream last delivered an event.",
+        ConstLabels: prometheus.Labels{"asp": asp},
+    }, func() float64 {
+        t, _ := lastEventAt.Load(asp)
+        return time.Since(t.(time.Time)).Seconds()
+    })
+}
+
+type metricsHandlerKey struct{}
+
+// dbMetricsHook times every bun query under the route stored in its context.
+type dbMetricsHook struct{}
+
+func (dbMetricsHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+    return ctx
+}
+
+func (dbMetricsHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+    handler, _ := ctx.Value(metricsHandlerKey{}).(string)
+    if handler == "" {
+        handler = "background"
+    }
+    dbQueryDuration.WithLabelValues(handler).Observe(time.Since(event.StartTime).Seconds())
+}
+
+type statusRecorder struct {
+    http.ResponseWriter
+    status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+    r.status = code
+    r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush keeps streaming handlers (live, export) working behind the recorder.
+func (r *statusRecorder) Flush() {
+    if f, ok := r.ResponseWriter.(http.Flusher); ok {
+        f.Flush()
+    }
+}
+
+// instrument records request latency and status for route and tags the
+// request context so DB queries are attributed to it.
+func instrument(route string, next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        start := time.Now()
+        rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+        r = r.WithContext(context.WithValue(r.Context(), metricsHandlerKey{}, route))
+
+        next(rec, r)
+
+        httpRequestDuration.WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).Observe(time.Since(start).Seconds())
+    }
+}
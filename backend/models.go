@@ -1,28 +1,164 @@
 package main
 
+// Events are raw ASP events. Streams share the table, so events in the same
+// millisecond are told apart by ID.
 type Events struct {
-    Timestamp_ms int64  `bun:",pk"`
+    ID           int64  `bun:",pk,autoincrement"`
+    Timestamp_ms int64  `bun:",notnull"`
     Eventdata    string `bun:",type:text,notnull"`
+    Asp          string `bun:",notnull,default:'arkade'"`
 }
 
 type VTXO struct {
-    Txid      string `bun:",pk" json:"txid"`
-    Vout      int    `bun:",pk" json:"vout"`
-    Amount    int64  `json:"amount"`
-    Script    string `json:"script"`
-    CreatedAt int64  `json:"createdAt"`
-    ExpiresAt int64  `json:"expiresAt"`
-    IsSpent   bool   `json:"isSpent"`
-    SpentBy   string `json:"spentBy"`
-    TxType    string `json:"txType"`
+    Txid      string `bun:",pk" json:"txid" parquet:"txid"`
+    Vout      int    `bun:",pk" json:"vout" parquet:"vout"`
+    Amount    int64  `json:"amount" parquet:"amount"`
+    Script    string `json:"script" parquet:"script"`
+    CreatedAt int64  `json:"createdAt" parquet:"created_at"`
+    ExpiresAt int64  `json:"expiresAt" parquet:"expires_at"`
+    IsSpent   bool   `json:"isSpent" parquet:"is_spent"`
+    SpentBy   string `json:"spentBy" parquet:"spent_by"`
+    SpentAt   int64  `bun:",notnull,default:0" json:"spentAt" parquet:"spent_at"`
+    IsSwept   bool   `bun:",notnull,default:false" json:"isSwept" parquet:"is_swept"`
+    TxType    string `json:"txType" parquet:"tx_type"`
+    Asp       string `bun:",notnull,default:'arkade'" json:"asp" parquet:"asp"`
 }
 
 type NetworkStats struct {
-    ID               int   `bun:",pk,autoincrement" json:"id"`
-    Timestamp        int64 `json:"timestamp"`
-    OnboardingVolume int64 `json:"onboardingVolume"`
-    OffboardingVolume int64 `json:"offboardingVolume"`
-    NetworkLiquidity int64 `json:"networkLiquidity"`
-    VirtualTxCount   int   `json:"virtualTxCount"`
-    VirtualTxVolume  int64 `json:"virtualTxVolume"`
+    ID               int   `bun:",pk,autoincrement" json:"id" parquet:"id"`
+    Timestamp        int64 `json:"timestamp" parquet:"timestamp"`
+    OnboardingVolume int64 `json:"onboardingVolume" parquet:"onboarding_volume"`
+    OffboardingVolume int64 `json:"offboardingVolume" parquet:"offboarding_volume"`
+    NetworkLiquidity int64 `json:"networkLiquidity" parquet:"network_liquidity"`
+    VirtualTxCount   int   `json:"virtualTxCount" parquet:"virtual_tx_count"`
+    VirtualTxVolume  int64 `json:"virtualTxVolume" parquet:"virtual_tx_volume"`
+    // Distinct scripts that received or spent VTXOs over the last day, week
+    // and month, split into first-time and returning scripts
+    ActiveScriptsDay      int `bun:",notnull,default:0" json:"activeScriptsDay" parquet:"active_scripts_day"`
+    NewScriptsDay         int `bun:",notnull,default:0" json:"newScriptsDay" parquet:"new_scripts_day"`
+    ReturningScriptsDay   int `bun:",notnull,default:0" json:"returningScriptsDay" parquet:"returning_scripts_day"`
+    ActiveScriptsWeek     int `bun:",notnull,default:0" json:"activeScriptsWeek" parquet:"active_scripts_week"`
+    NewScriptsWeek        int `bun:",notnull,default:0" json:"newScriptsWeek" parquet:"new_scripts_week"`
+    ReturningScriptsWeek  int `bun:",notnull,default:0" json:"returningScriptsWeek" parquet:"returning_scripts_week"`
+    ActiveScriptsMonth    int `bun:",notnull,default:0" json:"activeScriptsMonth" parquet:"active_scripts_month"`
+    NewScriptsMonth       int `bun:",notnull,default:0" json:"newScriptsMonth" parquet:"new_scripts_month"`
+    ReturningScriptsMonth int `bun:",notnull,default:0" json:"returningScriptsMonth" parquet:"returning_scripts_month"`
+    Asp              string `bun:",notnull,default:'arkade'" json:"asp" parquet:"asp"`
+}
+
+type Watch struct {
+    ID          int64  `bun:",pk,autoincrement" json:"id"`
+    Kind        string `bun:",notnull" json:"kind"`
+    Target      string `bun:",notnull" json:"target"`
+    Script      string `json:"script,omitempty"`
+    Txid        string `json:"txid,omitempty"`
+    Vout        int    `json:"vout,omitempty"`
+    CallbackURL string `bun:",notnull" json:"callbackUrl"`
+    Secret      string `bun:",notnull" json:"secret,omitempty"`
+    ExpiryHours int    `json:"expiryHours"`
+    CreatedAt   int64  `json:"createdAt"`
+}
+
+type WebhookDelivery struct {
+    ID            int64  `bun:",pk,autoincrement" json:"id"`
+    WatchID       int64  `bun:",notnull" json:"watchId"`
+    Event         string `bun:",notnull" json:"event"`
+    Txid          string `json:"txid"`
+    Vout          int    `json:"vout"`
+    Payload       string `bun:",type:text,notnull" json:"payload"`
+    Status        string `bun:",notnull" json:"status"`
+    Attempts      int    `json:"attempts"`
+    LastError     string `bun:",type:text" json:"lastError,omitempty"`
+    ResponseCode  int    `json:"responseCode,omitempty"`
+    NextAttemptAt int64  `json:"nextAttemptAt"`
+    DeliveredAt   int64  `json:"deliveredAt,omitempty"`
+    CreatedAt     int64  `json:"createdAt"`
+}
+
+// APIKey grants a client a higher rate limit. Only the SHA-256 of the key is
+// stored; Prefix identifies it in listings.
+type APIKey struct {
+    ID        int64   `bun:",pk,autoincrement" json:"id"`
+    Name      string  `bun:",notnull" json:"name"`
+    Prefix    string  `bun:",notnull" json:"prefix"`
+    KeyHash   string  `bun:",unique,notnull" json:"-"`
+    Rate      float64 `json:"rate"` // requests per second
+    Burst     int     `json:"burst"`
+    CreatedAt int64   `json:"createdAt"`
+    RevokedAt int64   `json:"revokedAt,omitempty"`
+}
+
+// ConcentrationStats is an hourly snapshot of how unspent liquidity is spread
+// across scripts. Asp is empty for the whole network.
+type ConcentrationStats struct {
+    ID              int64   `bun:",pk,autoincrement" json:"-"`
+    Timestamp       int64   `json:"timestamp"`
+    Asp             string  `bun:",notnull,default:''" json:"asp"`
+    Holders         int     `json:"holders"`
+    Liquidity       int64   `json:"liquiditySats"`
+    TopTenShare     float64 `json:"top10Share"`
+    TopHundredShare float64 `json:"top100Share"`
+    Gini            float64 `json:"gini"`
+}
+
+// RichListOptOut hides a script from the public rich list.
+type RichListOptOut struct {
+    Script    string `bun:",pk" json:"script"`
+    Note      string `json:"note"`
+    CreatedAt int64  `json:"createdAt"`
+}
+
+// KnownScript records when a script first received a VTXO from an ASP, so
+// new-script counts need not group the whole vtxos table.
+type KnownScript struct {
+    Script    string `bun:",pk"`
+    Asp       string `bun:",pk"`
+    FirstSeen int64  `bun:",notnull"`
+}
+
+// Round is a commitment transaction. Inputs are the VTXOs forfeited into the
+// round and outputs the new VTXOs it creates; amounts are in sats.
+type Round struct {
+    Txid            string `bun:",pk" json:"txid"`
+    Asp             string `bun:",notnull" json:"asp"`
+    CreatedAt       int64  `json:"createdAt"`
+    InputCount      int    `json:"inputCount"`
+    NewVtxoCount    int    `json:"newVtxoCount"`
+    InputAmount     int64  `json:"inputAmount"`
+    OutputAmount    int64  `json:"outputAmount"`
+    BoardingAmount  int64  `json:"boardingAmount"`
+    ExitAmount      int64  `json:"exitAmount"`
+    RefreshedAmount int64  `json:"refreshedAmount"`
+    SweptAmount     int64  `json:"sweptAmount"`
+}
+
+// TxFee is the value balance of a transaction in sats: forfeited or spent
+// VTXOs in, new VTXOs out. Swept inputs are not counted. For ark
+// transactions Fee is what the ASP kept; for rounds it also includes
+// boarding and exits, which happen on L1.
+type TxFee struct {
+    Txid         string `bun:",pk" json:"txid"`
+    Asp          string `bun:",notnull" json:"asp"`
+    CreatedAt    int64  `json:"createdAt"`
+    IsCommitment bool   `json:"isCommitment"`
+    InputCount   int    `json:"inputCount"`
+    OutputCount  int    `json:"outputCount"`
+    InputAmount  int64  `json:"inputAmount"`
+    OutputAmount int64  `json:"outputAmount"`
+    Fee          int64  `json:"fee"`
+}
+
+// Alert records a network metric that left its rolling baseline.
+type Alert struct {
+    ID         int64   `bun:",pk,autoincrement" json:"id"`
+    CreatedAt  int64   `bun:",notnull" json:"createdAt"`
+    Asp        string  `bun:",notnull" json:"asp"`
+    Metric     string  `bun:",notnull" json:"metric"`
+    Direction  string  `bun:",notnull" json:"direction"`
+    Value      float64 `json:"value"`
+    Mean       float64 `json:"mean"`
+    StdDev     float64 `json:"stdDev"`
+    ZScore     float64 `json:"zScore"`
+    Message    string  `json:"message"`
+    NotifiedAt int64   `bun:",notnull,default:0" json:"-"`
 }
\ No newline at end of file
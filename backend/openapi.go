@@ -0,0 +1,18 @@
+package main
+
+import (
+    _ "embed"
+    "net/http"
+)
+
+// openAPISpec is the API contract; the Go client in ./client is generated from it.
+//
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// GetOpenAPISpec serves the OpenAPI document.
+func GetOpenAPISpec(w http.ResponseWriter, r *http.Request) error {
+    w.Header().Set("Content-Type", "application/yaml")
+    _, err := w.Write(openAPISpec)
+    return err
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http/httptest"
+    "os"
+    "sort"
+    "strings"
+    "testing"
+    "time"
+
+    "github.com/getkin/kin-openapi/openapi3"
+    "github.com/getkin/kin-openapi/openapi3filter"
+    "github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// TestResponsesMatchSpec runs every operation of openapi.yaml through the
+// router and validates the responses against the spec. It needs a MySQL
+// database it may wipe, given as ARKEXPLORER_TEST_DSN, e.g.
+// root:root@tcp(localhost:3306)/ark_test?parseTime=true
+func TestResponsesMatchSpec(t *testing.T) {
+    dsn := os.Getenv("ARKEXPLORER_TEST_DSN")
+    if dsn == "" {
+        t.Skip("ARKEXPLORER_TEST_DSN not set")
+    }
+    ctx := context.Background()
+
+    loader := openapi3.NewLoader()
+    doc, err := loader.LoadFromData(openAPISpec)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err := doc.Validate(ctx); err != nil {
+        t.Fatalf("invalid spec: %v", err)
+    }
+    specRouter, err := gorillamux.NewRouter(doc)
+    if err != nil {
+        t.Fatal(err)
+    }
+    // Streams and the spec itself are described as plain strings
+    openapi3filter.RegisterBodyDecoder("text/event-stream", openapi3filter.FileBodyDecoder)
+    openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.FileBodyDecoder)
+    openapi3filter.RegisterBodyDecoder("application/yaml", openapi3filter.FileBodyDecoder)
+
+    if err := openDB(dsn); err != nil {
+        t.Fatal(err)
+    }
+    defer DB.Close()
+    seedTestData(t, ctx)
+
+    anonRate, anonBurst = 1e6, 1e6
+    handler := newRouter(routerOptions{})
+    base := doc.Servers[0].URL
+
+    type testCase struct {
+        method, path, body string
+        header             map[string]string
+        status             int
+    }
+    run := func(tc testCase) *httptest.ResponseRecorder {
+        t.Helper()
+        req := httptest.NewRequest(tc.method, base+tc.path, strings.NewReader(tc.body))
+        if tc.body != "" {
+            req.Header.Set("Content-Type", "application/json")
+        }
+        for k, v := range tc.header {
+            req.Header.Set(k, v)
+        }
+        if strings.HasPrefix(tc.path, "/api/v1/live") {
+            // The stream only ends when the client goes away
+            c, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+            defer cancel()
+            req = req.WithContext(c)
+        }
+        rec := httptest.NewRecorder()
+        handler.ServeHTTP(rec, req)
+        if rec.Code != tc.status {
+            t.Errorf("%s %s: status %d, want %d: %s", tc.method, tc.path, rec.Code, tc.status, rec.Body.String())
+        }
+
+        route, params, err := specRouter.FindRoute(req)
+        if err != nil {
+            t.Errorf("%s %s: not in spec: %v", tc.method, tc.path, err)
+            return rec
+        }
+        covered[tc.method+" "+route.Path] = true
+        err = openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+            RequestValidationInput: &openapi3filter.RequestValidationInput{
+                Request:    req,
+                PathParams: params,
+                Route:      route,
+            },
+            Status:  rec.Code,
+            Header:  rec.Header(),
+            Body:    io.NopCloser(bytes.NewReader(rec.Body.Bytes())),
+            Options: &openapi3filter.Options{IncludeResponseStatus: true},
+        })
+        if err != nil {
+            t.Errorf("%s %s: response does not match spec: %v", tc.method, tc.path, err)
+        }
+        return rec
+    }
+
+    cases := []testCase{
+        {"GET", "/api/v1/stats", "", nil, 200},
+        {"GET", "/api/v1/stats?timeframe=1w&compare=week", "", nil, 200},
+        {"GET", "/api/v1/stats?timeframe=all%20time", "", nil, 200},
+        {"GET", "/api/v1/trends?timeframe=1w", "", nil, 200},
+        {"GET", "/api/v1/trends?breakdown=asp", "", nil, 200},
+        {"GET", "/api/v1/recent-transactions", "", nil, 200},
+        {"GET", "/api/v1/transactions", "", nil, 200},
+        {"GET", "/api/v1/transactions?type=offboard&limit=1", "", nil, 200},
+        {"GET", "/api/v1/tx/a1", "", nil, 200},
+        {"GET", "/api/v1/rounds", "", nil, 200},
+        {"GET", "/api/v1/rounds/r2", "", nil, 200},
+        {"GET", "/api/v1/rounds/missing", "", nil, 404},
+        {"GET", "/api/v1/asps", "", nil, 200},
+        {"GET", "/api/v1/expiry", "", nil, 200},
+        {"GET", "/api/v1/analytics/lifetimes", "", nil, 200},
+        {"GET", "/api/v1/analytics/amounts", "", nil, 200},
+        {"GET", "/api/v1/analytics/rounds", "", nil, 200},
+        {"GET", "/api/v1/analytics/fees", "", nil, 200},
+        {"GET", "/api/v1/analytics/refresh", "", nil, 200},
+        {"GET", "/api/v1/analytics/flows?timeframe=all%20time", "", nil, 200},
+        {"GET", "/api/v1/analytics/concentration", "", nil, 200},
+        {"GET", "/api/v1/analytics/rich-list", "", nil, 200},
+        {"GET", "/api/v1/alerts", "", nil, 200},
+        {"GET", "/api/v1/live", "", nil, 200},
+        {"GET", "/api/v1/export/vtxos", "", nil, 200},
+        {"GET", "/api/v1/export/stats?format=ndjson", "", nil, 200},
+        {"GET", "/api/v1/openapi.yaml", "", nil, 200},
+        {"GET", "/api/v1/stats?timeframe=1y", "", nil, 400},
+        {"GET", "/healthz", "", nil, 200},
+        {"GET", "/readyz", "", nil, 200},
+    }
+    covered = make(map[string]bool)
+    for _, tc := range cases {
+        run(tc)
+    }
+
+    // Watches need the id and secret of the one created
+    rec := run(testCase{"POST", "/api/v1/watches", `{"script":"` + testScript(2) + `","callbackUrl":"https://example.com/hook"}`, nil, 201})
+    var watch struct {
+        ID     int64  `json:"id"`
+        Secret string `json:"secret"`
+    }
+    if err := json.Unmarshal(rec.Body.Bytes(), &watch); err != nil {
+        t.Fatalf("decoding watch: %v", err)
+    }
+    auth := map[string]string{"X-Watch-Secret": watch.Secret}
+    run(testCase{"GET", fmt.Sprintf("/api/v1/watches/%d/deliveries", watch.ID), "", auth, 200})
+    run(testCase{"DELETE", fmt.Sprintf("/api/v1/watches/%d", watch.ID), "", auth, 204})
+
+    var missing []string
+    for path, item := range doc.Paths.Map() {
+        for method := range item.Operations() {
+            if !covered[method+" "+path] {
+                missing = append(missing, method+" "+path)
+            }
+        }
+    }
+    sort.Strings(missing)
+    if len(missing) > 0 {
+        t.Errorf("operations without a test case: %v", missing)
+    }
+}
+
+// covered records the operations TestResponsesMatchSpec has exercised.
+var covered map[string]bool
+
+func testScript(n int) string {
+    return fmt.Sprintf("5120%064x", n)
+}
+
+// seedTestData recreates the tables and feeds a boarding round, an ark
+// transaction, a cooperative offboard, a refresh of a swept VTXO and a
+// swept round output through the ingester.
+func seedTestData(t *testing.T, ctx context.Context) {
+    t.Helper()
+    for _, model := range []interface{}{
+        (*Events)(nil), (*VTXO)(nil), (*NetworkStats)(nil), (*Watch)(nil), (*WebhookDelivery)(nil),
+        (*APIKey)(nil), (*ConcentrationStats)(nil), (*RichListOptOut)(nil), (*Round)(nil), (*TxFee)(nil), (*Alert)(nil), (*KnownScript)(nil),
+    } {
+        if _, err := DB.NewDropTable().Model(model).IfExists().Exec(ctx); err != nil {
+            t.Fatal(err)
+        }
+    }
+    createTables(ctx)
+    if err := MigrateSchema(ctx); err != nil {
+        t.Fatal(err)
+    }
+
+    now := time.Now().Unix()
+    vtxo := func(txid string, vout int, amount int64, script int, createdAt int64, swept bool) map[string]interface{} {
+        return map[string]interface{}{
+            "outpoint":  map[string]interface{}{"txid": txid, "vout": vout},
+            "amount":    fmt.Sprint(amount),
+            "script":    testScript(script),
+            "createdAt": fmt.Sprint(createdAt),
+            "expiresAt": fmt.Sprint(createdAt + 7*24*3600),
+            "isSwept":   swept,
+        }
+    }
+    tx := func(txid string, spent, spendable []interface{}) map[string]interface{} {
+        return map[string]interface{}{"txid": txid, "spentVtxos": spent, "spendableVtxos": spendable}
+    }
+    events := []map[string]interface{}{
+        {"commitmentTx": tx("r1", []interface{}{}, []interface{}{
+            vtxo("r1", 0, 100000, 1, now-3600, false),
+            vtxo("r1", 1, 50000, 2, now-3600, false),
+        })},
+        {"arkTx": tx("a1", []interface{}{
+            vtxo("r1", 0, 100000, 1, now-3600, false),
+        }, []interface{}{
+            vtxo("a1", 0, 60000, 2, now-1800, false),
+            vtxo("a1", 1, 39000, 1, now-1800, false),
+        })},
+        {"commitmentTx": tx("r2", []interface{}{
+            vtxo("a1", 1, 39000, 1, now-1800, false),
+        }, []interface{}{})},
+        {"commitmentTx": tx("r3", []interface{}{
+            vtxo("r1", 1, 50000, 2, now-3600, true),
+        }, []interface{}{
+            vtxo("r3", 0, 50000, 2, now-600, false),
+        })},
+        // An expired round output announced again once the ASP swept it
+        {"commitmentTx": tx("r4", []interface{}{}, []interface{}{
+            vtxo("r4", 0, 20000, 3, now-10*24*3600, false),
+        })},
+        {"commitmentTx": tx("r4", []interface{}{}, []interface{}{
+            vtxo("r4", 0, 20000, 3, now-10*24*3600, true),
+        })},
+    }
+    for _, e := range events {
+        data, err := json.Marshal(e)
+        if err != nil {
+            t.Fatal(err)
+        }
+        inflightEvents.Add(1)
+        processEvent(defaultASP, "data: "+string(data))
+    }
+
+    updateNetworkStats()
+    updateConcentrationStats(ctx)
+    _, err := DB.NewInsert().Model(&Alert{
+        CreatedAt: now, Asp: defaultASP, Metric: "virtual_tx_count", Direction: "spike",
+        Value: 10, Mean: 1, StdDev: 1, ZScore: 9, Message: "test alert",
+    }).Exec(ctx)
+    if err != nil {
+        t.Fatal(err)
+    }
+}
@@ -4,48 +4,87 @@ import (
     "bufio"
     "context"
     "encoding/json"
+    "errors"
     "fmt"
     "log"
     "net/http"
+    "runtime/debug"
     "strings"
     "time"
 )
 
-func ConsumeSSEStream(url string) {
+// ConsumeSSEStream reads an ASP's transaction stream until ctx is cancelled,
+// reconnecting after errors.
+func ConsumeSSEStream(ctx context.Context, asp, url string) {
+    for ctx.Err() == nil {
+        err := readSSEStream(ctx, asp, url)
+        setStreamConnected(asp, false)
+        if ctx.Err() != nil {
+            return
+        }
+        
+        // Keep retrying; /readyz reports the outage once it lasts too long
+        log.Printf("Stream %s error: %v. Reconnecting...", asp, err)
+        sseReconnects.WithLabelValues(asp).Inc()
+        select {
+        case <-ctx.Done():
+            return
+        case <-time.After(5 * time.Second):
+        }
+    }
+}
+
+func readSSEStream(ctx context.Context, asp, url string) error {
     client := &http.Client{Timeout: 0}
-    req, err := http.NewRequest("GET", url, nil)
+    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
     if err != nil {
-        log.Fatalf("Error creating request: %v", err)
+        return err
     }
     req.Header.Set("Accept", "text/event-stream")
 
     resp, err := client.Do(req)
     if err != nil {
-        log.Fatalf("Error connecting to SSE stream: %v", err)
+        return err
     }
     defer resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("unexpected status %s", resp.Status)
+    }
+    setStreamConnected(asp, true)
 
     reader := bufio.NewReader(resp.Body)
     for {
         line, err := reader.ReadString('\n')
         if err != nil {
-            log.Printf("Stream read error: %v. Reconnecting...", err)
-            time.Sleep(5 * time.Second)
-            go ConsumeSSEStream(url)
-            return
+            return err
         }
         line = strings.TrimSpace(line)
-        go processEvent(line)
+        inflightEvents.Add(1)
+        go processEvent(asp, line)
     }
 }
 
-func processEvent(data string) {
-    if !strings.HasPrefix(data, "data") {
+func processEvent(asp, data string) {
+    defer inflightEvents.Done()
+    // Events are handled outside supervise, so one bad event must not take
+    // the process down
+    defer func() {
+        if r := recover(); r != nil {
+            log.Printf("Panic processing event from %s: %v\n%s", asp, r, debug.Stack())
+            parseFailures.WithLabelValues(asp, "panic").Inc()
+        }
+    }()
+    
+    payload, ok := strings.CutPrefix(data, "data:")
+    if !ok {
         return
     }
+    markEventReceived(asp)
+    payload = strings.TrimPrefix(payload, " ")
     
     var jsonData map[string]interface{}
-    if err := json.Unmarshal([]byte(data[6:]), &jsonData); err != nil {
+    if err := json.Unmarshal([]byte(payload), &jsonData); err != nil {
+        parseFailures.WithLabelValues(asp, "invalid_json").Inc()
         return
     }
     
@@ -57,24 +96,75 @@ func processEvent(data string) {
     now := time.Now().UnixMilli()
     
     // Store raw event
-    DB.NewInsert().Model(&Events{Timestamp_ms: now, Eventdata: data[6:]}).Exec(ctx)
+    if _, err := DB.NewInsert().Model(&Events{Timestamp_ms: now, Eventdata: payload, Asp: asp}).Exec(ctx); err != nil {
+        log.Printf("Error storing event from %s: %v", asp, err)
+        eventStoreFailures.WithLabelValues(asp).Inc()
+    }
     
     // Parse and store VTXOs
-    go parseAndStore(jsonData, ctx)
+    parseAndStore(asp, jsonData, now/1000, ctx)
+}
+
+// parseAndStore decodes and stores an event received at receivedAt (unix
+// seconds), which dates spends of transactions without outputs.
+func parseAndStore(asp string, data map[string]interface{}, receivedAt int64, ctx context.Context) {
+    tx, err := decodeEvent(asp, data, receivedAt)
+    if err == errUnknownPayload {
+        parseFailures.WithLabelValues(asp, "unknown_payload").Inc()
+        return
+    }
+    if err != nil {
+        log.Printf("Malformed transaction from %s: %v", asp, err)
+        parseFailures.WithLabelValues(asp, "malformed_tx").Inc()
+        return
+    }
+    storeTransaction(ctx, tx)
+    sseEventsParsed.WithLabelValues(asp).Inc()
 }
 
-func parseAndStore(data map[string]interface{}, ctx context.Context) {
+var errUnknownPayload = errors.New("event has neither arkTx nor commitmentTx")
+
+// parsedVTXO is a VTXO as it appears in an event, before it is stored.
+type parsedVTXO struct {
+    VTXO
+    IsSwept bool
+}
+
+// parsedTx is an ASP transaction event decoded into the rows it produces.
+// Outputs are created unspent; Inputs are marked spent at CreatedAt.
+type parsedTx struct {
+    Txid         string
+    Asp          string
+    TxType       string
+    IsCommitment bool
+    CreatedAt    int64
+    Outputs      []parsedVTXO
+    Inputs       []parsedVTXO
+}
+
+// decodeEvent extracts and classifies the transaction of an ASP event. It is
+// shared by the ingester and the verifier so both agree on what an event
+// means. Malformed events return an error instead of panicking.
+func decodeEvent(asp string, data map[string]interface{}, receivedAt int64) (tx *parsedTx, err error) {
+    // decodeTransaction asserts the event shape
+    defer func() {
+        if r := recover(); r != nil {
+            tx, err = nil, fmt.Errorf("%v", r)
+        }
+    }()
+
     if arkTx, ok := data["arkTx"].(map[string]interface{}); ok {
-        processTransaction(arkTx, false, ctx)
+        return decodeTransaction(asp, arkTx, false, receivedAt), nil
     } else if commitTx, ok := data["commitmentTx"].(map[string]interface{}); ok {
-        processTransaction(commitTx, true, ctx)
+        return decodeTransaction(asp, commitTx, true, receivedAt), nil
     }
+    return nil, errUnknownPayload
 }
 
-func processTransaction(tx map[string]interface{}, isCommitmentTx bool, ctx context.Context) {
+func decodeTransaction(asp string, tx map[string]interface{}, isCommitmentTx bool, receivedAt int64) *parsedTx {
     spentVtxos := tx["spentVtxos"].([]interface{})
     spendableVtxos := tx["spendableVtxos"].([]interface{})
-    // txid := tx["txid"].(string)
+    txid, _ := tx["txid"].(string)
     
     hasInputs := len(spentVtxos) > 0
     hasOutputs := len(spendableVtxos) > 0
@@ -87,49 +177,143 @@ func processTransaction(tx map[string]interface{}, isCommitmentTx bool, ctx cont
         }
     }
     
-    // Insert spendable VTXOs
-    for _, v := range spendableVtxos {
+    parsed := &parsedTx{
+        Txid:         txid,
+        Asp:          asp,
+        TxType:       determineTxType(hasInputs, hasOutputs, isCommitmentTx, isRefresh, false),
+        IsCommitment: isCommitmentTx,
+        Outputs:      make([]parsedVTXO, 0, len(spendableVtxos)),
+        Inputs:       make([]parsedVTXO, 0, len(spentVtxos)),
+    }
+    
+    decode := func(v interface{}, isSpent bool) parsedVTXO {
         vtxo := v.(map[string]interface{})
         outpoint := vtxo["outpoint"].(map[string]interface{})
         isSwept := vtxo["isSwept"] == true
+        return parsedVTXO{
+            VTXO: VTXO{
+                Txid:      outpoint["txid"].(string),
+                Vout:      parseInt(outpoint["vout"]),
+                Amount:    parseAmount(vtxo["amount"]),
+                Script:    vtxo["script"].(string),
+                CreatedAt: parseInt64(vtxo["createdAt"]),
+                ExpiresAt: parseInt64(vtxo["expiresAt"]),
+                IsSpent:   isSpent,
+                IsSwept:   isSwept,
+                Asp:       asp,
+            },
+            IsSwept: isSwept,
+        }
+    }
+    
+    for _, v := range spendableVtxos {
+        out := decode(v, false)
+        out.TxType = determineTxType(hasInputs, hasOutputs, isCommitmentTx, isRefresh, out.IsSwept)
+        parsed.Outputs = append(parsed.Outputs, out)
+        if out.CreatedAt > parsed.CreatedAt {
+            parsed.CreatedAt = out.CreatedAt
+        }
+    }
+    // Offboards create no VTXOs to date them
+    if parsed.CreatedAt == 0 {
+        parsed.CreatedAt = receivedAt
+    }
+    
+    for _, v := range spentVtxos {
+        in := decode(v, true)
+        in.SpentBy = txid
+        in.SpentAt = parsed.CreatedAt
+        if in.IsSwept {
+            in.TxType = "offboard"
+        } else {
+            in.TxType = determineTxType(hasInputs, hasOutputs, isCommitmentTx, isRefresh, false)
+        }
+        parsed.Inputs = append(parsed.Inputs, in)
+    }
+    
+    return parsed
+}
+
+// storeTransaction upserts the VTXOs of tx and notifies watches and live
+// subscribers.
+func storeTransaction(ctx context.Context, tx *parsedTx) {
+    live := &LiveTx{
+        Txid:         tx.Txid,
+        Asp:          tx.Asp,
+        TxType:       tx.TxType,
+        IsCommitment: tx.IsCommitment,
+        CreatedAt:    tx.CreatedAt,
+        Inputs:       make([]LiveVTXO, 0, len(tx.Inputs)),
+        Outputs:      make([]LiveVTXO, 0, len(tx.Outputs)),
+    }
+    
+    // Insert spendable VTXOs
+    for i := range tx.Outputs {
+        out := &tx.Outputs[i]
+        row := &out.VTXO
+        // A VTXO announced again after expiry may have been swept since
+        DB.NewInsert().Model(row).On("DUPLICATE KEY UPDATE").
+            Set("tx_type = VALUES(tx_type)").
+            Set("is_swept = VALUES(is_swept)").
+            Exec(ctx)
+        vtxoUpserts.WithLabelValues(tx.Asp, "spendable").Inc()
         
-        txType := determineTxType(hasInputs, hasOutputs, isCommitmentTx, isRefresh, isSwept)
+        if out.IsSwept {
+            notifyWatches(ctx, "swept", tx.Txid, row)
+        } else {
+            notifyWatches(ctx, "created", tx.Txid, row)
+        }
         
-        DB.NewInsert().Model(&VTXO{
-            Txid:      outpoint["txid"].(string),
-            Vout:      parseInt(outpoint["vout"]),
-            Amount:    parseAmount(vtxo["amount"]),
-            Script:    vtxo["script"].(string),
-            CreatedAt: parseInt64(vtxo["createdAt"]),
-            ExpiresAt: parseInt64(vtxo["expiresAt"]),
-            IsSpent:   false,
-            TxType:    txType,
-        }).On("DUPLICATE KEY UPDATE").Set("tx_type = VALUES(tx_type)").Exec(ctx)
+        live.Outputs = append(live.Outputs, liveVTXO(row, out.IsSwept))
     }
     
-    // Process spent VTXOs too (NEW - this is the minimal addition needed)
-    for _, v := range spentVtxos {
-        vtxo := v.(map[string]interface{})
-        outpoint := vtxo["outpoint"].(map[string]interface{})
-        isSwept := vtxo["isSwept"] == true
+    // Spent VTXOs; usually the row exists from the event that created it
+    for i := range tx.Inputs {
+        in := &tx.Inputs[i]
+        row := &in.VTXO
+        DB.NewInsert().Model(row).On("DUPLICATE KEY UPDATE").
+            Set("tx_type = VALUES(tx_type)").
+            Set("is_spent = VALUES(is_spent)").
+            Set("spent_by = VALUES(spent_by)").
+            Set("spent_at = VALUES(spent_at)").
+            Set("is_swept = VALUES(is_swept)").
+            Exec(ctx)
+        vtxoUpserts.WithLabelValues(tx.Asp, "spent").Inc()
         
-        var txType string
-        if isSwept {
-            txType = "offboard"
+        if in.IsSwept {
+            notifyWatches(ctx, "swept", tx.Txid, row)
         } else {
-            txType = determineTxType(hasInputs, hasOutputs, isCommitmentTx, isRefresh, isSwept)
+            notifyWatches(ctx, "spent", tx.Txid, row)
         }
         
-        DB.NewInsert().Model(&VTXO{
-            Txid:      outpoint["txid"].(string),
-            Vout:      parseInt(outpoint["vout"]),
-            Amount:    parseAmount(vtxo["amount"]),
-            Script:    vtxo["script"].(string),
-            CreatedAt: parseInt64(vtxo["createdAt"]),
-            ExpiresAt: parseInt64(vtxo["expiresAt"]),
-            IsSpent:   true, // Note: spent VTXOs should have IsSpent = true
-            TxType:    txType,
-        }).On("DUPLICATE KEY UPDATE").Set("tx_type = VALUES(tx_type)").Exec(ctx)
+        live.Inputs = append(live.Inputs, liveVTXO(row, in.IsSwept))
+    }
+    
+    seen := make([]*VTXO, 0, len(tx.Outputs)+len(tx.Inputs))
+    for i := range tx.Outputs {
+        seen = append(seen, &tx.Outputs[i].VTXO)
+    }
+    for i := range tx.Inputs {
+        seen = append(seen, &tx.Inputs[i].VTXO)
+    }
+    storeKnownScripts(ctx, seen)
+    storeTxFee(ctx, tx)
+    if tx.IsCommitment {
+        storeRound(ctx, tx)
+    }
+    
+    invalidateResponseCache()
+    PublishLiveTx(live)
+}
+
+func liveVTXO(v *VTXO, isSwept bool) LiveVTXO {
+    return LiveVTXO{
+        Txid:      v.Txid,
+        Vout:      v.Vout,
+        Amount:    v.Amount,
+        Script:    v.Script,
+        ExpiresAt: v.ExpiresAt,
+        IsSwept:   isSwept,
     }
 }
 
@@ -168,7 +352,7 @@ func BackfillEvents() {
     fmt.Println("Starting backfill from events table...")
     
     var events []Events
-    if err := DB.NewSelect().Model(&events).Order("timestamp_ms ASC").Scan(ctx); err != nil {
+    if err := DB.NewSelect().Model(&events).Order("timestamp_ms ASC", "id ASC").Scan(ctx); err != nil {
         log.Printf("Error fetching events: %v", err)
         return
     }
@@ -180,7 +364,7 @@ func BackfillEvents() {
         if err := json.Unmarshal([]byte(event.Eventdata), &jsonData); err != nil {
             continue
         }
-        parseAndStore(jsonData, ctx)
+        parseAndStore(event.Asp, jsonData, event.Timestamp_ms/1000, ctx)
         
         if (i+1)%100 == 0 {
             fmt.Printf("Processed %d/%d events\n", i+1, len(events))
@@ -202,48 +386,53 @@ func UpdateNetworkStats(periodHours ...int) {
     }
     periodStartSeconds := (now - int64(hours)*3600000) / 1000
     
-    var liquidity, vtxVolume, onboardVol, offboardVol int64
-    var vtxCount int
-    
-    // Network liquidity
-    DB.NewSelect().Model((*VTXO)(nil)).
-        Where("is_spent = ?", false).
-        ColumnExpr("COALESCE(SUM(amount), 0)").
-        Scan(ctx, &liquidity)
-    
-    // Virtual transactions
-    vtxCount, _ = DB.NewSelect().Model((*VTXO)(nil)).
-        Where("tx_type = ? AND created_at > ?", "virtual", periodStartSeconds).
-        Count(ctx)
-    
-    DB.NewSelect().Model((*VTXO)(nil)).
-        Where("tx_type = ? AND created_at > ?", "virtual", periodStartSeconds).
-        ColumnExpr("COALESCE(SUM(amount), 0)").
-        Scan(ctx, &vtxVolume)
-    
-    // Onboarding volume
-    DB.NewSelect().Model((*VTXO)(nil)).
-        Where("tx_type = ? AND created_at > ?", "onboard", periodStartSeconds).
-        ColumnExpr("COALESCE(SUM(amount), 0)").
-        Scan(ctx, &onboardVol)
-    
-    // Offboarding volume
-    DB.NewSelect().Model((*VTXO)(nil)).
-        Where("tx_type = ? AND created_at > ?", "offboard", periodStartSeconds).
-        ColumnExpr("COALESCE(SUM(amount), 0)").
-        Scan(ctx, &offboardVol)
-    
-    DB.NewInsert().Model(&NetworkStats{
-        Timestamp:         now,
-        OnboardingVolume:  onboardVol,
-        OffboardingVolume: offboardVol,
-        NetworkLiquidity:  liquidity,
-        VirtualTxCount:    vtxCount,
-        VirtualTxVolume:   vtxVolume,
-    }).Exec(ctx)
-    
-    log.Printf("Stats updated (%dh): liquidity=%d, vtx_count=%d, vtx_vol=%d, onboard=%d, offboard=%d",
-        hours, liquidity, vtxCount, vtxVolume, onboardVol, offboardVol)
+    for _, asp := range knownASPs(ctx) {
+        var liquidity, vtxVolume, onboardVol, offboardVol int64
+        var vtxCount int
+        
+        // Network liquidity
+        DB.NewSelect().Model((*VTXO)(nil)).
+            Where("is_spent = ? AND asp = ?", false, asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &liquidity)
+        
+        // Virtual transactions
+        vtxCount, _ = DB.NewSelect().Model((*VTXO)(nil)).
+            Where("tx_type = ? AND created_at > ? AND asp = ?", "virtual", periodStartSeconds, asp).
+            Count(ctx)
+        
+        DB.NewSelect().Model((*VTXO)(nil)).
+            Where("tx_type = ? AND created_at > ? AND asp = ?", "virtual", periodStartSeconds, asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &vtxVolume)
+        
+        // Onboarding volume
+        DB.NewSelect().Model((*VTXO)(nil)).
+            Where("tx_type = ? AND created_at > ? AND asp = ?", "onboard", periodStartSeconds, asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &onboardVol)
+        
+        // Offboarding volume
+        DB.NewSelect().Model((*VTXO)(nil)).
+            Where("tx_type = ? AND created_at > ? AND asp = ?", "offboard", periodStartSeconds, asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &offboardVol)
+        
+        stats := &NetworkStats{
+            Timestamp:         now,
+            OnboardingVolume:  onboardVol,
+            OffboardingVolume: offboardVol,
+            NetworkLiquidity:  liquidity,
+            VirtualTxCount:    vtxCount,
+            VirtualTxVolume:   vtxVolume,
+            Asp:               asp,
+        }
+        setScriptActivity(ctx, stats, now/1000)
+        DB.NewInsert().Model(stats).Exec(ctx)
+        
+        log.Printf("Stats updated (%s, %dh): liquidity=%d, vtx_count=%d, vtx_vol=%d, onboard=%d, offboard=%d",
+            asp, hours, liquidity, vtxCount, vtxVolume, onboardVol, offboardVol)
+    }
 }
 
 func parseAmount(amount interface{}) int64 {
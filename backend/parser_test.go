@@ -0,0 +1,89 @@
+package main
+
+import "testing"
+
+func TestDecodeTransactionSweptOutput(t *testing.T) {
+    event := map[string]interface{}{
+        "commitmentTx": map[string]interface{}{
+            "txid":       "r4",
+            "spentVtxos": []interface{}{},
+            "spendableVtxos": []interface{}{
+                map[string]interface{}{
+                    "outpoint":  map[string]interface{}{"txid": "r4", "vout": 0.0},
+                    "amount":    "20000",
+                    "script":    testScript(3),
+                    "createdAt": "1700000000",
+                    "expiresAt": "1700604800",
+                    "isSwept":   true,
+                },
+            },
+        },
+    }
+    tx, err := decodeEvent(defaultASP, event, 1700700000)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(tx.Outputs) != 1 {
+        t.Fatalf("got %d outputs, want 1", len(tx.Outputs))
+    }
+    // The stored row must carry the flag, not only the wrapper
+    if out := tx.Outputs[0]; !out.IsSwept || !out.VTXO.IsSwept || out.VTXO.IsSpent {
+        t.Errorf("swept output decoded as swept=%t row swept=%t spent=%t", out.IsSwept, out.VTXO.IsSwept, out.VTXO.IsSpent)
+    }
+}
+
+func TestDecodeEvent(t *testing.T) {
+    vtxo := func(txid string, amount string, swept bool) map[string]interface{} {
+        return map[string]interface{}{
+            "outpoint":  map[string]interface{}{"txid": txid, "vout": 0.0},
+            "amount":    amount,
+            "script":    testScript(1),
+            "createdAt": "1700000000",
+            "expiresAt": "1700604800",
+            "isSwept":   swept,
+        }
+    }
+    event := func(kind, txid string, spent, spendable []interface{}) map[string]interface{} {
+        return map[string]interface{}{kind: map[string]interface{}{
+            "txid": txid, "spentVtxos": spent, "spendableVtxos": spendable,
+        }}
+    }
+    none := []interface{}{}
+
+    tests := []struct {
+        name       string
+        event      map[string]interface{}
+        txType     string
+        commitment bool
+        createdAt  int64
+        inputType  string
+    }{
+        {"boarding round", event("commitmentTx", "r1", none, []interface{}{vtxo("r1", "1000", false)}), "onboard", true, 1700000000, ""},
+        {"ark transaction", event("arkTx", "a1", []interface{}{vtxo("r1", "1000", false)}, []interface{}{vtxo("a1", "900", false)}), "virtual", false, 1700000000, "virtual"},
+        {"offboard is dated on receipt", event("commitmentTx", "o1", []interface{}{vtxo("a1", "900", false)}, none), "offboard", true, 1700700000, "offboard"},
+        {"refresh of a swept VTXO", event("commitmentTx", "r2", []interface{}{vtxo("r1", "1000", true)}, []interface{}{vtxo("r2", "1000", false)}), "refresh", true, 1700000000, "offboard"},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            tx, err := decodeEvent(defaultASP, tt.event, 1700700000)
+            if err != nil {
+                t.Fatal(err)
+            }
+            if tx.TxType != tt.txType || tx.IsCommitment != tt.commitment || tx.CreatedAt != tt.createdAt {
+                t.Errorf("got %s commitment=%t at %d, want %s commitment=%t at %d", tx.TxType, tx.IsCommitment, tx.CreatedAt, tt.txType, tt.commitment, tt.createdAt)
+            }
+            for _, in := range tx.Inputs {
+                if !in.IsSpent || in.SpentBy != tx.Txid || in.SpentAt != tx.CreatedAt || in.TxType != tt.inputType {
+                    t.Errorf("input spent=%t by %q at %d as %s", in.IsSpent, in.SpentBy, in.SpentAt, in.TxType)
+                }
+            }
+        })
+    }
+
+    if _, err := decodeEvent(defaultASP, map[string]interface{}{"heartbeat": true}, 0); err != errUnknownPayload {
+        t.Errorf("unknown payload: got %v, want errUnknownPayload", err)
+    }
+    if _, err := decodeEvent(defaultASP, map[string]interface{}{"arkTx": map[string]interface{}{"txid": "x"}}, 0); err == nil {
+        t.Error("malformed event decoded without error")
+    }
+}
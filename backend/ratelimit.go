@@ -0,0 +1,186 @@
+package main
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "log"
+    "math"
+    "net"
+    "net/http"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+)
+
+// Anonymous clients are limited per IP; these are overridden by flags.
+var (
+    anonRate       = 5.0 // tokens per second
+    anonBurst      = 60
+    trustForwarded = false
+)
+
+// Request costs in tokens. Exports and graph data scan far more rows than
+// the other endpoints, and all-time windows scan the whole table.
+const (
+    defaultCost   = 1
+    graphCost     = 5
+    expensiveCost = 20
+)
+
+// requestCost prices r for the rate limiter.
+func requestCost(r *http.Request) float64 {
+    path := r.URL.Path
+    switch {
+    case strings.Contains(path, "/export/"):
+        return expensiveCost
+    case r.URL.Query().Get("timeframe") == "all time":
+        return expensiveCost
+    case strings.HasSuffix(path, "/trends"):
+        return graphCost
+    }
+    return defaultCost
+}
+
+type tokenBucket struct {
+    tokens float64
+    last   time.Time
+}
+
+// rateLimiter holds one token bucket per client (IP or API key).
+var rateLimiter = struct {
+    sync.Mutex
+    buckets map[string]*tokenBucket
+}{buckets: make(map[string]*tokenBucket)}
+
+// take refills the bucket of client at rate up to burst and removes cost
+// tokens if there are enough. It returns the tokens left and, when refused,
+// how long until cost tokens are available.
+func take(client string, rate float64, burst int, cost float64) (bool, float64, time.Duration) {
+    rateLimiter.Lock()
+    defer rateLimiter.Unlock()
+
+    now := time.Now()
+    b := rateLimiter.buckets[client]
+    if b == nil {
+        b = &tokenBucket{tokens: float64(burst), last: now}
+        rateLimiter.buckets[client] = b
+    }
+    b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+    b.last = now
+
+    // A request costing more than the whole bucket can still run from full
+    cost = math.Min(cost, float64(burst))
+    if b.tokens < cost {
+        wait := time.Duration((cost - b.tokens) / rate * float64(time.Second))
+        return false, b.tokens, wait
+    }
+    b.tokens -= cost
+    return true, b.tokens, 0
+}
+
+// pruneBuckets drops buckets that have refilled completely; they are
+// indistinguishable from new ones.
+func pruneBuckets(maxIdle time.Duration) {
+    rateLimiter.Lock()
+    defer rateLimiter.Unlock()
+    for client, b := range rateLimiter.buckets {
+        if time.Since(b.last) > maxIdle {
+            delete(rateLimiter.buckets, client)
+        }
+    }
+}
+
+// apiKeyCache maps key hashes to active keys. The CLI edits keys from another
+// process, so it is reloaded periodically.
+var apiKeyCache = struct {
+    sync.RWMutex
+    byHash map[string]*APIKey
+}{byHash: make(map[string]*APIKey)}
+
+func loadAPIKeys(ctx context.Context) error {
+    var keys []APIKey
+    if err := DB.NewSelect().Model(&keys).Where("revoked_at = 0").Scan(ctx); err != nil {
+        return err
+    }
+    byHash := make(map[string]*APIKey, len(keys))
+    for i := range keys {
+        byHash[keys[i].KeyHash] = &keys[i]
+    }
+    apiKeyCache.Lock()
+    apiKeyCache.byHash = byHash
+    apiKeyCache.Unlock()
+    return nil
+}
+
+func hashAPIKey(key string) string {
+    sum := sha256.Sum256([]byte(key))
+    return hex.EncodeToString(sum[:])
+}
+
+func lookupAPIKey(key string) *APIKey {
+    apiKeyCache.RLock()
+    defer apiKeyCache.RUnlock()
+    return apiKeyCache.byHash[hashAPIKey(key)]
+}
+
+// StartRateLimitMaintenance reloads API keys and prunes idle buckets.
+func StartRateLimitMaintenance(ctx context.Context) {
+    ticker := time.NewTicker(time.Minute)
+    defer ticker.Stop()
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if err := loadAPIKeys(ctx); err != nil {
+                log.Printf("Error reloading API keys: %v", err)
+            }
+            pruneBuckets(10 * time.Minute)
+        }
+    }
+}
+
+func clientIP(r *http.Request) string {
+    if trustForwarded {
+        if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+            return strings.TrimSpace(strings.Split(fwd, ",")[0])
+        }
+    }
+    host, _, err := net.SplitHostPort(r.RemoteAddr)
+    if err != nil {
+        return r.RemoteAddr
+    }
+    return host
+}
+
+// rateLimit applies a token bucket per API key (X-API-Key header) or, for
+// anonymous requests, per client IP, and reports the bucket in X-RateLimit-*
+// headers.
+func rateLimit(next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        client, rate, burst := "ip:"+clientIP(r), anonRate, anonBurst
+        if key := r.Header.Get("X-API-Key"); key != "" {
+            k := lookupAPIKey(key)
+            if k == nil {
+                writeError(w, r, unauthorized("invalid API key"))
+                return
+            }
+            client, rate, burst = "key:"+strconv.FormatInt(k.ID, 10), k.Rate, k.Burst
+        }
+
+        ok, remaining, wait := take(client, rate, burst, requestCost(r))
+        h := w.Header()
+        h.Set("X-RateLimit-Limit", strconv.Itoa(burst))
+        h.Set("X-RateLimit-Remaining", strconv.Itoa(int(remaining)))
+        h.Set("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil((float64(burst)-remaining)/rate))))
+        if !ok {
+            h.Set("Retry-After", fmt.Sprint(int(math.Ceil(wait.Seconds()))))
+            writeError(w, r, tooManyRequests())
+            return
+        }
+        next(w, r)
+    }
+}
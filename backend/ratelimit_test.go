@@ -0,0 +1,41 @@
+package main
+
+import (
+    "testing"
+    "time"
+)
+
+func TestTake(t *testing.T) {
+    // A slow rate so no tokens refill during the test
+    const rate = 0.001
+    tests := []struct {
+        name  string
+        costs []float64
+        ok    []bool
+        left  float64
+    }{
+        {"burst is allowed", []float64{1, 1, 1}, []bool{true, true, true}, 0},
+        {"refused past the burst", []float64{2, 2}, []bool{true, false}, 1},
+        {"oversized cost runs from full", []float64{10, 1}, []bool{true, false}, 0},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            client := "test " + tt.name
+            var left float64
+            var wait time.Duration
+            for i, cost := range tt.costs {
+                var ok bool
+                ok, left, wait = take(client, rate, 3, cost)
+                if ok != tt.ok[i] {
+                    t.Fatalf("take %d: ok=%t, want %t", i, ok, tt.ok[i])
+                }
+                if ok && wait != 0 || !ok && wait <= 0 {
+                    t.Errorf("take %d: ok=%t with wait %v", i, ok, wait)
+                }
+            }
+            if left < tt.left || left > tt.left+0.01 {
+                t.Errorf("%v tokens left, want %v", left, tt.left)
+            }
+        })
+    }
+}
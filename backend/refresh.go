@@ -0,0 +1,118 @@
+package main
+
+import (
+    "context"
+    "net/http"
+    "strings"
+    "time"
+
+    "github.com/uptrace/bun"
+)
+
+// A VTXO is refreshed when a refresh transaction spends it. decodeTransaction
+// calls a transaction a refresh because it spends swept VTXOs, so those
+// count as refreshed too, dated by spent_at. A VTXO is swept when the ASP
+// reclaimed it after expiry and nobody refreshed it since; it is dated by
+// expires_at. refreshedInput is isRefreshedExpr for a decoded input.
+const (
+    isRefreshedExpr = "(is_spent AND (is_swept OR tx_type = 'refresh'))"
+    isSweptExpr     = "(is_swept AND NOT is_spent)"
+    refreshTimeExpr = "CASE WHEN is_spent THEN spent_at ELSE expires_at END"
+)
+
+func refreshedInput(in *parsedVTXO) bool {
+    return in.IsSwept || in.TxType == "refresh"
+}
+
+// refreshMetrics summarizes refreshes and sweeps over a period or bucket.
+type refreshMetrics struct {
+    DisplayDate                 string   `bun:"display_date" json:"displayDate,omitempty"`
+    Asp                         string   `bun:"asp" json:"asp,omitempty"`
+    RefreshCount                int      `bun:"refresh_count" json:"refreshCount"`
+    RefreshVolume               float64  `bun:"refresh_volume" json:"refreshVolume"`
+    RefreshedBeforeExpiryVolume float64  `bun:"refreshed_before_expiry_volume" json:"refreshedBeforeExpiryVolume"`
+    SweptCount                  int      `bun:"swept_count" json:"sweptCount"`
+    SweptVolume                 float64  `bun:"swept_volume" json:"sweptVolume"`
+    AvgSecondsBeforeExpiry      *float64 `bun:"avg_seconds_before_expiry" json:"avgSecondsBeforeExpiry"`
+    RefreshedShare              *float64 `bun:"-" json:"refreshedShare"`
+}
+
+// setShare computes the percent of expiring liquidity (refreshed or swept)
+// that was refreshed before expiry; nil when nothing expired or refreshed.
+func (m *refreshMetrics) setShare() {
+    if total := m.RefreshVolume + m.SweptVolume; total > 0 {
+        share := m.RefreshedBeforeExpiryVolume / total * 100
+        m.RefreshedShare = &share
+    }
+}
+
+// refreshQuery selects refresh metrics for VTXOs refreshed or swept since
+// the given time (unix seconds).
+func refreshQuery(asp string, since int64) *bun.SelectQuery {
+    return whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp).
+        ColumnExpr("COUNT(CASE WHEN "+isRefreshedExpr+" THEN 1 END) AS refresh_count").
+        ColumnExpr("COALESCE(SUM(CASE WHEN "+isRefreshedExpr+" THEN amount END), 0) / 100000000.0 AS refresh_volume").
+        ColumnExpr("COALESCE(SUM(CASE WHEN "+isRefreshedExpr+" AND spent_at <= expires_at THEN amount END), 0) / 100000000.0 AS refreshed_before_expiry_volume").
+        ColumnExpr("COUNT(CASE WHEN "+isSweptExpr+" THEN 1 END) AS swept_count").
+        ColumnExpr("COALESCE(SUM(CASE WHEN "+isSweptExpr+" THEN amount END), 0) / 100000000.0 AS swept_volume").
+        ColumnExpr("AVG(CASE WHEN "+isRefreshedExpr+" AND expires_at > 0 THEN expires_at - spent_at END) AS avg_seconds_before_expiry").
+        Where("(is_spent = ? AND spent_at >= ?) OR ("+isSweptExpr+" AND expires_at >= ?)", true, since, since)
+}
+
+// refreshSeries buckets refresh metrics like queryTrends. dateFormat is the
+// bucket expression over created_at and is applied to refreshTimeExpr. With byASP
+// set, each bucket is split into one row per ASP.
+func refreshSeries(ctx context.Context, dateFormat string, since int64, asp string, byASP bool) ([]refreshMetrics, error) {
+    groupBy := []string{"display_date"}
+    q := refreshQuery(asp, since).
+        ColumnExpr(strings.ReplaceAll(dateFormat, "created_at", refreshTimeExpr) + " AS display_date")
+    if byASP {
+        q = q.ColumnExpr("asp")
+        groupBy = append(groupBy, "asp")
+    }
+
+    series := make([]refreshMetrics, 0)
+    if err := q.Group(groupBy...).Order(groupBy...).Scan(ctx, &series); err != nil {
+        return nil, err
+    }
+    for i := range series {
+        series[i].setShare()
+    }
+    return series, nil
+}
+
+// GetRefreshAnalytics reports how much liquidity wallets refresh before it
+// expires versus how much the ASP sweeps, for the timeframe and per bucket.
+func GetRefreshAnalytics(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    asp := r.URL.Query().Get("asp")
+    now := time.Now().Unix()
+    var since int64
+    if window := timeframeSeconds(timeframe); window > 0 {
+        since = now - window
+    }
+
+    var summary refreshMetrics
+    if err := refreshQuery(asp, since).Scan(ctx, &summary); err != nil {
+        return err
+    }
+    summary.setShare()
+
+    dateFormat := bucketDateFormat(timeframe)
+    series, err := refreshSeries(ctx, dateFormat, since, asp, false)
+    if err != nil {
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "summary":   summary,
+        "series":    series,
+        "timestamp": now * 1000,
+    })
+}
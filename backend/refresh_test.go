@@ -0,0 +1,32 @@
+package main
+
+import (
+    "context"
+    "os"
+    "testing"
+)
+
+func TestSweptOutputsCounted(t *testing.T) {
+    dsn := os.Getenv("ARKEXPLORER_TEST_DSN")
+    if dsn == "" {
+        t.Skip("ARKEXPLORER_TEST_DSN not set")
+    }
+    ctx := context.Background()
+    if err := openDB(dsn); err != nil {
+        t.Fatal(err)
+    }
+    defer DB.Close()
+    seedTestData(t, ctx)
+
+    var m refreshMetrics
+    if err := refreshQuery("", 0).Scan(ctx, &m); err != nil {
+        t.Fatal(err)
+    }
+    if m.SweptCount != 1 || m.SweptVolume != 0.0002 {
+        t.Errorf("swept %d VTXOs, %v BTC; want 1, 0.0002", m.SweptCount, m.SweptVolume)
+    }
+    // r3 refreshed the swept r1:1
+    if m.RefreshCount != 1 || m.RefreshVolume != 0.0005 {
+        t.Errorf("refreshed %d VTXOs, %v BTC; want 1, 0.0005", m.RefreshCount, m.RefreshVolume)
+    }
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+    "context"
+    "database/sql"
+    "errors"
+    "log"
+    "net/http"
+    "strconv"
+    "time"
+)
+
+// roundFromTx summarizes a commitment transaction the way flowSeries splits
+// it. Refreshed inputs (see refreshedInput) are reissued first; boarding
+// funds arrive from L1 and exits leave to it, so the remaining outputs in
+// excess of the other inputs were boarded and the other inputs in excess of
+// them exited. SweptAmount is the part of the refreshed inputs the ASP had
+// swept.
+func roundFromTx(tx *parsedTx) *Round {
+    round := &Round{
+        Txid:         tx.Txid,
+        Asp:          tx.Asp,
+        CreatedAt:    tx.CreatedAt,
+        InputCount:   len(tx.Inputs),
+        NewVtxoCount: len(tx.Outputs),
+    }
+    var forfeited int64
+    for i := range tx.Inputs {
+        in := &tx.Inputs[i]
+        round.InputAmount += in.Amount
+        if in.IsSwept {
+            round.SweptAmount += in.Amount
+        }
+        if refreshedInput(in) {
+            round.RefreshedAmount += in.Amount
+        } else {
+            forfeited += in.Amount
+        }
+    }
+    for _, out := range tx.Outputs {
+        round.OutputAmount += out.Amount
+    }
+    rest := round.OutputAmount - min(round.OutputAmount, round.RefreshedAmount)
+    matched := min(rest, forfeited)
+    round.BoardingAmount = rest - matched
+    round.ExitAmount = forfeited - matched
+    return round
+}
+
+// storeRound upserts the round of a commitment transaction. An ASP can
+// announce the same round more than once, so later events win.
+func storeRound(ctx context.Context, tx *parsedTx) {
+    _, err := DB.NewInsert().Model(roundFromTx(tx)).
+        On("DUPLICATE KEY UPDATE").
+        Set("created_at = VALUES(created_at)").
+        Set("input_count = VALUES(input_count)").
+        Set("new_vtxo_count = VALUES(new_vtxo_count)").
+        Set("input_amount = VALUES(input_amount)").
+        Set("output_amount = VALUES(output_amount)").
+        Set("boarding_amount = VALUES(boarding_amount)").
+        Set("exit_amount = VALUES(exit_amount)").
+        Set("refreshed_amount = VALUES(refreshed_amount)").
+        Set("swept_amount = VALUES(swept_amount)").
+        Exec(ctx)
+    if err != nil {
+        log.Printf("Error storing round %s: %v", tx.Txid, err)
+    }
+}
+
+// GetRounds pages through rounds newest first, like GetTransactions.
+func GetRounds(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    limit := 50
+    if v := r.URL.Query().Get("limit"); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n <= 0 {
+            return badRequest("limit must be a positive integer")
+        }
+        limit = min(n, 500)
+    }
+    from, err := int64Param(r, "from")
+    if err != nil {
+        return err
+    }
+    to, err := int64Param(r, "to")
+    if err != nil {
+        return err
+    }
+
+    q := filterASP(DB.NewSelect().Model((*Round)(nil)), r)
+    if from > 0 {
+        q = q.Where("created_at >= ?", from)
+    }
+    if to > 0 {
+        q = q.Where("created_at < ?", to)
+    }
+    if c := r.URL.Query().Get("cursor"); c != "" {
+        createdAt, txid, err := decodeTxCursor(c)
+        if err != nil {
+            return badRequest("invalid cursor")
+        }
+        q = q.Where("(created_at < ? OR (created_at = ? AND txid < ?))", createdAt, createdAt, txid)
+    }
+
+    rounds := make([]Round, 0, limit+1)
+    if err := q.OrderExpr("created_at DESC, txid DESC").Limit(limit+1).Scan(ctx, &rounds); err != nil {
+        return err
+    }
+
+    var nextCursor *string
+    if len(rounds) > limit {
+        rounds = rounds[:limit]
+        last := rounds[limit-1]
+        c := encodeTxCursor(last.CreatedAt, last.Txid)
+        nextCursor = &c
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "rounds":     rounds,
+        "nextCursor": nextCursor,
+    })
+}
+
+// GetRound returns a round with the VTXOs it forfeited and created.
+func GetRound(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+    txid := r.PathValue("txid")
+
+    round := new(Round)
+    if err := DB.NewSelect().Model(round).Where("txid = ?", txid).Scan(ctx); err != nil {
+        if errors.Is(err, sql.ErrNoRows) {
+            return notFound("round %s not found", txid)
+        }
+        return err
+    }
+
+    inputs := make([]VTXO, 0)
+    if err := DB.NewSelect().Model(&inputs).Where("spent_by = ?", txid).Order("txid", "vout").Scan(ctx); err != nil {
+        return err
+    }
+    outputs := make([]VTXO, 0)
+    if err := DB.NewSelect().Model(&outputs).Where("txid = ?", txid).Order("vout").Scan(ctx); err != nil {
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, struct {
+        *Round
+        Inputs  []VTXO `json:"inputs"`
+        Outputs []VTXO `json:"outputs"`
+    }{round, inputs, outputs})
+}
+
+// GetRoundStats reports round cadence (time between consecutive rounds of
+// an ASP), round size and per-bucket round flows for the timeframe.
+func GetRoundStats(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+
+    timeframe, err := parseTimeframe(r)
+    if err != nil {
+        return err
+    }
+    now := time.Now().Unix()
+    var since int64
+    if window := timeframeSeconds(timeframe); window > 0 {
+        since = now - window
+    }
+
+    var summary struct {
+        Count           int     `bun:"count" json:"count"`
+        MeanInputs      float64 `bun:"mean_inputs" json:"meanInputs"`
+        MeanNewVtxos    float64 `bun:"mean_new_vtxos" json:"meanNewVtxos"`
+        BoardingVolume  float64 `bun:"boarding_volume" json:"boardingVolume"`
+        ExitVolume      float64 `bun:"exit_volume" json:"exitVolume"`
+        RefreshedVolume float64 `bun:"refreshed_volume" json:"refreshedVolume"`
+        SweptVolume     float64 `bun:"swept_volume" json:"sweptVolume"`
+    }
+    err = filterASP(DB.NewSelect().Model((*Round)(nil)), r).
+        ColumnExpr("COUNT(*) AS count").
+        ColumnExpr("COALESCE(AVG(input_count), 0) AS mean_inputs").
+        ColumnExpr("COALESCE(AVG(new_vtxo_count), 0) AS mean_new_vtxos").
+        ColumnExpr("COALESCE(SUM(boarding_amount), 0) / 100000000.0 AS boarding_volume").
+        ColumnExpr("COALESCE(SUM(exit_amount), 0) / 100000000.0 AS exit_volume").
+        ColumnExpr("COALESCE(SUM(refreshed_amount), 0) / 100000000.0 AS refreshed_volume").
+        ColumnExpr("COALESCE(SUM(swept_amount), 0) / 100000000.0 AS swept_volume").
+        Where("created_at >= ?", since).
+        Scan(ctx, &summary)
+    if err != nil {
+        return err
+    }
+
+    // Gaps are taken per ASP so interleaved ASPs do not shorten each other's
+    // intervals
+    gaps := filterASP(DB.NewSelect().Model((*Round)(nil)), r).
+        ColumnExpr("created_at - LAG(created_at) OVER (PARTITION BY asp ORDER BY created_at) AS gap").
+        Where("created_at >= ?", since)
+    var meanInterval float64
+    err = DB.NewSelect().
+        TableExpr("(?) AS gaps", gaps).
+        ColumnExpr("COALESCE(AVG(gap), 0)").
+        Where("gap IS NOT NULL").
+        Scan(ctx, &meanInterval)
+    if err != nil {
+        return err
+    }
+    intervals, err := nearestRankPercentiles(ctx,
+        DB.NewSelect().TableExpr("(?) AS gaps", gaps).Where("gap IS NOT NULL"), "", "gap", []int{500, 900, 990})
+    if err != nil {
+        return err
+    }
+    sizes, err := nearestRankPercentiles(ctx,
+        filterASP(DB.NewSelect().Model((*Round)(nil)), r).Where("created_at >= ?", since), "", "new_vtxo_count", []int{500, 900, 990})
+    if err != nil {
+        return err
+    }
+    interval, size := intervals[""], sizes[""]
+    if interval == nil {
+        interval = make([]int64, 3)
+    }
+    if size == nil {
+        size = make([]int64, 3)
+    }
+
+    dateFormat := bucketDateFormat(timeframe)
+    type RoundBucket struct {
+        DisplayDate     string  `bun:"display_date" json:"displayDate"`
+        Rounds          int     `bun:"rounds" json:"rounds"`
+        NewVtxos        int     `bun:"new_vtxos" json:"newVtxos"`
+        BoardingVolume  float64 `bun:"boarding_volume" json:"boardingVolume"`
+        ExitVolume      float64 `bun:"exit_volume" json:"exitVolume"`
+        RefreshedVolume float64 `bun:"refreshed_volume" json:"refreshedVolume"`
+    }
+    series := make([]RoundBucket, 0)
+    err = filterASP(DB.NewSelect().Model((*Round)(nil)), r).
+        ColumnExpr(dateFormat+" AS display_date").
+        ColumnExpr("COUNT(*) AS rounds").
+        ColumnExpr("SUM(new_vtxo_count) AS new_vtxos").
+        ColumnExpr("SUM(boarding_amount) / 100000000.0 AS boarding_volume").
+        ColumnExpr("SUM(exit_amount) / 100000000.0 AS exit_volume").
+        ColumnExpr("SUM(refreshed_amount) / 100000000.0 AS refreshed_volume").
+        Where("created_at >= ?", since).
+        Group("display_date").
+        Order("display_date").
+        Scan(ctx, &series)
+    if err != nil {
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, map[string]interface{}{
+        "timeframe": timeframe,
+        "summary":   summary,
+        "intervalSeconds": map[string]interface{}{
+            "mean": meanInterval,
+            "p50":  interval[0],
+            "p90":  interval[1],
+            "p99":  interval[2],
+        },
+        "newVtxosPerRound": map[string]interface{}{
+            "mean": summary.MeanNewVtxos,
+            "p50":  size[0],
+            "p90":  size[1],
+            "p99":  size[2],
+        },
+        "series":    series,
+        "timestamp": now * 1000,
+    })
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestRoundFromTx(t *testing.T) {
+    forfeited := func(amount int64) parsedVTXO {
+        return parsedVTXO{VTXO: VTXO{Amount: amount, TxType: "virtual"}}
+    }
+    refreshed := func(amount int64) parsedVTXO {
+        return parsedVTXO{VTXO: VTXO{Amount: amount, TxType: "refresh"}}
+    }
+    swept := func(amount int64) parsedVTXO {
+        return parsedVTXO{VTXO: VTXO{Amount: amount, TxType: "virtual", IsSwept: true}, IsSwept: true}
+    }
+    output := func(amount int64) parsedVTXO {
+        return parsedVTXO{VTXO: VTXO{Amount: amount}}
+    }
+
+    tests := []struct {
+        name                                   string
+        inputs, outputs                        []parsedVTXO
+        boarding, exit, refreshedAmt, sweptAmt int64
+    }{
+        {"boarding only", nil, []parsedVTXO{output(1000)}, 1000, 0, 0, 0},
+        {"exit only", []parsedVTXO{forfeited(1000)}, nil, 0, 1000, 0, 0},
+        {"refresh", []parsedVTXO{refreshed(1000)}, []parsedVTXO{output(1000)}, 0, 0, 1000, 0},
+        {"swept input is refreshed", []parsedVTXO{swept(500)}, []parsedVTXO{output(500)}, 0, 0, 500, 500},
+        {"refresh and boarding", []parsedVTXO{refreshed(1000), forfeited(300)}, []parsedVTXO{output(1000), output(500)}, 200, 0, 1000, 0},
+        {"refresh and exit", []parsedVTXO{refreshed(1000), forfeited(800)}, []parsedVTXO{output(1200)}, 0, 600, 1000, 0},
+        {"refreshed beyond outputs", []parsedVTXO{refreshed(1000)}, []parsedVTXO{output(600)}, 0, 0, 1000, 0},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            round := roundFromTx(&parsedTx{Txid: "r", IsCommitment: true, Inputs: tt.inputs, Outputs: tt.outputs})
+            if round.InputCount != len(tt.inputs) || round.NewVtxoCount != len(tt.outputs) {
+                t.Errorf("counts %d/%d, want %d/%d", round.InputCount, round.NewVtxoCount, len(tt.inputs), len(tt.outputs))
+            }
+            if round.BoardingAmount != tt.boarding || round.ExitAmount != tt.exit {
+                t.Errorf("boarding %d exit %d, want %d %d", round.BoardingAmount, round.ExitAmount, tt.boarding, tt.exit)
+            }
+            if round.RefreshedAmount != tt.refreshedAmt || round.SweptAmount != tt.sweptAmt {
+                t.Errorf("refreshed %d swept %d, want %d %d", round.RefreshedAmount, round.SweptAmount, tt.refreshedAmt, tt.sweptAmt)
+            }
+        })
+    }
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+    "compress/gzip"
+    "fmt"
+    "net/http"
+    "runtime/debug"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// aggregateCacheTTL bounds how long stats and trends responses are reused
+// between ingested transactions.
+const aggregateCacheTTL = 30 * time.Second
+
+// middleware wraps a handler with cross-cutting behaviour.
+type middleware func(http.HandlerFunc) http.HandlerFunc
+
+// chain applies mws to h; the first middleware is the outermost.
+func chain(h http.HandlerFunc, mws ...middleware) http.HandlerFunc {
+    for i := len(mws) - 1; i >= 0; i-- {
+        h = mws[i](h)
+    }
+    return h
+}
+
+type routerOptions struct {
+    CORS      bool
+    AccessLog bool
+}
+
+// newRouter registers the versioned /api/v1 routes, the deprecated
+// unversioned aliases and the operational endpoints.
+func newRouter(opts routerOptions) http.Handler {
+    mux := http.NewServeMux()
+
+    route := func(pattern string, h apiHandler, extra ...middleware) {
+        // Metrics are labelled by path pattern; the method is its own label
+        label := pattern[strings.Index(pattern, " ")+1:]
+        mws := []middleware{withRequestID}
+        if opts.AccessLog {
+            mws = append(mws, logRequests)
+        }
+        // Recovery sits inside logging and metrics so panics are counted as 500s
+        mws = append(mws, func(next http.HandlerFunc) http.HandlerFunc { return instrument(label, next) }, recoverPanics, rateLimit)
+        mws = append(mws, extra...)
+        mux.HandleFunc(pattern, chain(handleErrors(h), mws...))
+    }
+
+    // Live streams are never gzipped so events are not held in the compressor
+    route("GET /api/v1/stats", GetStats, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/recent-transactions", GetRecentTxs, gzipResponses)
+    route("GET /api/v1/transactions", GetTransactions, gzipResponses)
+    route("GET /api/v1/tx/{txid}", SearchTx, gzipResponses)
+    route("GET /api/v1/trends", GetNetworkTrends, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/rounds", GetRounds, gzipResponses)
+    route("GET /api/v1/rounds/{txid}", GetRound, gzipResponses)
+    route("GET /api/v1/asps", GetASPComparison, gzipResponses)
+    route("GET /api/v1/expiry", GetExpiryCalendar, gzipResponses)
+    route("GET /api/v1/analytics/lifetimes", GetLifetimes, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/amounts", GetAmountDistribution, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/rounds", GetRoundStats, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/fees", GetFeeAnalytics, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/refresh", GetRefreshAnalytics, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/flows", GetFlows, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/concentration", GetConcentration, gzipResponses, cached(aggregateCacheTTL))
+    route("GET /api/v1/analytics/rich-list", GetRichList, gzipResponses, cached(aggregateCacheTTL))
+    route("POST /api/v1/watches", createWatch)
+    route("DELETE /api/v1/watches/{id}", deleteWatch)
+    route("GET /api/v1/alerts", GetAlerts, gzipResponses)
+    route("GET /api/v1/watches/{id}/deliveries", GetWatchDeliveries, gzipResponses)
+    route("GET /api/v1/live", GetLive)
+    route("GET /api/v1/export/{dataset}", GetExport, gzipResponses)
+    route("GET /api/v1/openapi.yaml", GetOpenAPISpec, gzipResponses)
+
+    // Deprecated aliases kept for existing clients; they accept any method
+    // and query-string parameters like before
+    legacy := func(pattern, successor string, h apiHandler, extra ...middleware) {
+        route(pattern, h, append([]middleware{deprecated(successor)}, extra...)...)
+    }
+    legacy("/api/stats", "/api/v1/stats", GetStats, gzipResponses, cached(aggregateCacheTTL))
+    legacy("/api/recent-transactions", "/api/v1/recent-transactions", GetRecentTxs, gzipResponses)
+    legacy("/api/transactions", "/api/v1/transactions", GetTransactions, gzipResponses)
+    legacy("/api/search", "/api/v1/tx/{txid}", SearchTx, gzipResponses)
+    legacy("/api/trends", "/api/v1/trends", GetNetworkTrends, gzipResponses, cached(aggregateCacheTTL))
+    legacy("/api/asps", "/api/v1/asps", GetASPComparison, gzipResponses)
+    legacy("/api/expiry", "/api/v1/expiry", GetExpiryCalendar, gzipResponses)
+    legacy("/api/watches", "/api/v1/watches", HandleWatches)
+    legacy("/api/watches/deliveries", "/api/v1/watches/{id}/deliveries", GetWatchDeliveries, gzipResponses)
+    legacy("/api/live", "/api/v1/live", GetLive)
+    legacy("/api/export/{dataset}", "/api/v1/export/{dataset}", GetExport, gzipResponses)
+    legacy("/api/openapi.yaml", "/api/v1/openapi.yaml", GetOpenAPISpec, gzipResponses)
+
+    mux.Handle("GET /metrics", promhttp.Handler())
+    mux.HandleFunc("GET /healthz", Healthz)
+    mux.HandleFunc("GET /readyz", Readyz)
+
+    // CORS wraps the whole mux so preflight requests are answered before
+    // method matching rejects OPTIONS
+    if opts.CORS {
+        return enableCORS(mux.ServeHTTP)
+    }
+    return mux
+}
+
+func enableCORS(next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Access-Control-Allow-Origin", "*")
+        w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+        w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-Watch-Secret, X-Request-ID, X-API-Key")
+        w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, Retry-After")
+
+        if r.Method == "OPTIONS" {
+            w.WriteHeader(http.StatusOK)
+            return
+        }
+
+        next(w, r)
+    }
+}
+
+// deprecated marks responses of an unversioned alias with its replacement.
+func deprecated(successor string) middleware {
+    return func(next http.HandlerFunc) http.HandlerFunc {
+        return func(w http.ResponseWriter, r *http.Request) {
+            w.Header().Set("Deprecation", "true")
+            w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+            next(w, r)
+        }
+    }
+}
+
+// recoverPanics turns a handler panic into a logged 500 instead of a dropped
+// connection.
+func recoverPanics(next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        defer func() {
+            v := recover()
+            if v == nil {
+                return
+            }
+            if v == http.ErrAbortHandler {
+                panic(v)
+            }
+            logf(r.Context(), "panic in %s %s: %v\n%s", r.Method, r.URL.Path, v, debug.Stack())
+            writeError(w, r, internalError(fmt.Errorf("panic: %v", v)))
+        }()
+        next(w, r)
+    }
+}
+
+// logRequests writes one access log line per request.
+func logRequests(next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        start := time.Now()
+        rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+        next(rec, r)
+        logf(r.Context(), "%s %s %d %s", r.Method, r.URL.RequestURI(), rec.status, time.Since(start).Round(time.Millisecond))
+    }
+}
+
+var gzipWriters = sync.Pool{New: func() interface{} { return gzip.NewWriter(nil) }}
+
+// gzipResponseWriter compresses the body once the status is known, so 204
+// and 304 responses stay empty.
+type gzipResponseWriter struct {
+    http.ResponseWriter
+    gz      *gzip.Writer
+    decided bool
+}
+
+func (g *gzipResponseWriter) WriteHeader(code int) {
+    if !g.decided {
+        g.decided = true
+        h := g.Header()
+        if code != http.StatusNoContent && code != http.StatusNotModified && h.Get("Content-Encoding") == "" {
+            h.Set("Content-Encoding", "gzip")
+            h.Del("Content-Length")
+            g.gz = gzipWriters.Get().(*gzip.Writer)
+            g.gz.Reset(g.ResponseWriter)
+        }
+    }
+    g.ResponseWriter.WriteHeader(code)
+}
+
+func (g *gzipResponseWriter) Write(b []byte) (int, error) {
+    if !g.decided {
+        g.WriteHeader(http.StatusOK)
+    }
+    if g.gz != nil {
+        return g.gz.Write(b)
+    }
+    return g.ResponseWriter.Write(b)
+}
+
+func (g *gzipResponseWriter) Flush() {
+    if g.gz != nil {
+        g.gz.Flush()
+    }
+    if f, ok := g.ResponseWriter.(http.Flusher); ok {
+        f.Flush()
+    }
+}
+
+func (g *gzipResponseWriter) close() {
+    if g.gz != nil {
+        g.gz.Close()
+        gzipWriters.Put(g.gz)
+    }
+}
+
+// gzipResponses compresses responses for clients that accept gzip.
+func gzipResponses(next http.HandlerFunc) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Add("Vary", "Accept-Encoding")
+        if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+            next(w, r)
+            return
+        }
+        gw := &gzipResponseWriter{ResponseWriter: w}
+        defer gw.close()
+        next(gw, r)
+    }
+}
@@ -5,56 +5,119 @@ import (
     "time"
 )
 
-func StartStatsUpdater() {
+// lastLiveStats holds the previous snapshot per ASP so live subscribers get
+// deltas. Only the updater goroutine touches it.
+var lastLiveStats = make(map[string]NetworkStats)
+
+func StartStatsUpdater(ctx context.Context) {
     ticker := time.NewTicker(1 * time.Minute)
-    go func() {
-        for range ticker.C {
+    defer ticker.Stop()
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
             updateNetworkStats()
         }
-    }()
+    }
 }
 
 func updateNetworkStats() {
     ctx := context.Background()
     now := time.Now().UnixMilli()
+    dayAgo := now/1000 - 86400 // created_at is in seconds
     
-    var liquidity int64
-    DB.NewSelect().
-        Model((*VTXO)(nil)).
-        Where("is_spent = ?", false).
-        ColumnExpr("SUM(amount)").
-        Scan(ctx, &liquidity)
-    
-    var vtxCount int
-    DB.NewSelect().
-        Model((*VTXO)(nil)).
-        Where("tx_type = ?", "virtual").
-        Where("created_at > ?", now - 86400000).
-        Count(ctx)
-    
-    var onboardVol int64
-    DB.NewSelect().
-        Model((*VTXO)(nil)).
-        Where("tx_type = ?", "onboard").
-        Where("created_at > ?", now - 86400000).
-        ColumnExpr("SUM(amount)").
-        Scan(ctx, &onboardVol)
-    
-    var offboardVol int64
-    DB.NewSelect().
-        Model((*VTXO)(nil)).
-        Where("tx_type = ?", "offboard").
-        Where("created_at > ?", now - 86400000).
-        ColumnExpr("SUM(amount)").
-        Scan(ctx, &offboardVol)
+    type statsDelta struct {
+        Asp               string `json:"asp"`
+        NetworkLiquidity  int64  `json:"networkLiquidity"`
+        OnboardingVolume  int64  `json:"onboardingVolume"`
+        OffboardingVolume int64  `json:"offboardingVolume"`
+        VirtualTxCount    int    `json:"virtualTxCount"`
+        VirtualTxVolume   int64  `json:"virtualTxVolume"`
+    }
+    snapshots := make([]NetworkStats, 0)
+    deltas := make([]statsDelta, 0)
     
-    stats := &NetworkStats{
-        Timestamp:         now,
-        OnboardingVolume:  onboardVol,
-        OffboardingVolume: offboardVol,
-        NetworkLiquidity:  liquidity,
-        VirtualTxCount:    vtxCount,
+    for _, asp := range knownASPs(ctx) {
+        var liquidity int64
+        DB.NewSelect().
+            Model((*VTXO)(nil)).
+            Where("is_spent = ?", false).
+            Where("asp = ?", asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &liquidity)
+        
+        unspentCount, _ := DB.NewSelect().
+            Model((*VTXO)(nil)).
+            Where("is_spent = ?", false).
+            Where("asp = ?", asp).
+            Count(ctx)
+        networkLiquidityGauge.WithLabelValues(asp).Set(float64(liquidity))
+        unspentVTXOGauge.WithLabelValues(asp).Set(float64(unspentCount))
+        
+        vtxCount, _ := DB.NewSelect().
+            Model((*VTXO)(nil)).
+            Where("tx_type = ?", "virtual").
+            Where("created_at > ?", dayAgo).
+            Where("asp = ?", asp).
+            Count(ctx)
+        
+        var vtxVolume int64
+        DB.NewSelect().
+            Model((*VTXO)(nil)).
+            Where("tx_type = ?", "virtual").
+            Where("created_at > ?", dayAgo).
+            Where("asp = ?", asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &vtxVolume)
+        
+        var onboardVol int64
+        DB.NewSelect().
+            Model((*VTXO)(nil)).
+            Where("tx_type = ?", "onboard").
+            Where("created_at > ?", dayAgo).
+            Where("asp = ?", asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &onboardVol)
+        
+        var offboardVol int64
+        DB.NewSelect().
+            Model((*VTXO)(nil)).
+            Where("tx_type = ?", "offboard").
+            Where("created_at > ?", dayAgo).
+            Where("asp = ?", asp).
+            ColumnExpr("COALESCE(SUM(amount), 0)").
+            Scan(ctx, &offboardVol)
+        
+        stats := &NetworkStats{
+            Timestamp:         now,
+            OnboardingVolume:  onboardVol,
+            OffboardingVolume: offboardVol,
+            NetworkLiquidity:  liquidity,
+            VirtualTxCount:    vtxCount,
+            VirtualTxVolume:   vtxVolume,
+            Asp:               asp,
+        }
+        setScriptActivity(ctx, stats, now/1000)
+        
+        DB.NewInsert().Model(stats).Exec(ctx)
+        
+        prev := lastLiveStats[asp]
+        snapshots = append(snapshots, *stats)
+        deltas = append(deltas, statsDelta{
+            Asp:               asp,
+            NetworkLiquidity:  stats.NetworkLiquidity - prev.NetworkLiquidity,
+            OnboardingVolume:  stats.OnboardingVolume - prev.OnboardingVolume,
+            OffboardingVolume: stats.OffboardingVolume - prev.OffboardingVolume,
+            VirtualTxCount:    stats.VirtualTxCount - prev.VirtualTxCount,
+            VirtualTxVolume:   stats.VirtualTxVolume - prev.VirtualTxVolume,
+        })
+        lastLiveStats[asp] = *stats
     }
     
-    DB.NewInsert().Model(stats).Exec(ctx)
+    PublishLiveStats(map[string]interface{}{
+        "timestamp": now,
+        "stats":     snapshots,
+        "deltas":    deltas,
+    })
 }
\ No newline at end of file
@@ -0,0 +1,61 @@
+package main
+
+import (
+    "context"
+    "log"
+    "runtime/debug"
+    "sync"
+    "time"
+)
+
+// inflightEvents counts events between receipt and the end of parsing so
+// shutdown can wait for them instead of dropping them mid-parse.
+var inflightEvents sync.WaitGroup
+
+// workers tracks supervised background goroutines.
+var workers sync.WaitGroup
+
+// supervise runs fn until ctx is cancelled, restarting it when it panics or
+// returns early.
+func supervise(ctx context.Context, name string, fn func(ctx context.Context)) {
+    workers.Add(1)
+    go func() {
+        defer workers.Done()
+        for {
+            runWorker(ctx, name, fn)
+            if ctx.Err() != nil {
+                return
+            }
+            log.Printf("Worker %s stopped, restarting in 5s", name)
+            select {
+            case <-ctx.Done():
+                return
+            case <-time.After(5 * time.Second):
+            }
+        }
+    }()
+}
+
+func runWorker(ctx context.Context, name string, fn func(ctx context.Context)) {
+    defer func() {
+        if r := recover(); r != nil {
+            log.Printf("Worker %s panicked: %v\n%s", name, r, debug.Stack())
+        }
+    }()
+    fn(ctx)
+}
+
+// waitTimeout waits for wg, giving up after d. It reports whether wg finished.
+func waitTimeout(wg *sync.WaitGroup, d time.Duration) bool {
+    done := make(chan struct{})
+    go func() {
+        wg.Wait()
+        close(done)
+    }()
+    select {
+    case <-done:
+        return true
+    case <-time.After(d):
+        return false
+    }
+}
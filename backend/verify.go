@@ -0,0 +1,248 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "flag"
+    "fmt"
+    "log"
+    "sort"
+)
+
+// verifyCategories are the kinds of inconsistency `arkexplorer verify`
+// reports, in report order.
+var verifyCategories = []string{
+    "missing",         // outpoint in the event archive but not in vtxos
+    "unexpected",      // row in vtxos that no event produced
+    "spent_mismatch",  // is_spent disagrees with the replay
+    "tx_type_drift",   // tx_type disagrees with the replay
+    "amount_mismatch", // amount disagrees with the replay
+    "orphan_spend",    // spend of an outpoint no earlier event created
+    "parse_failure",   // event that could not be decoded
+    "unbalanced_tx",   // ark transaction spending nothing or less than it creates
+    "fee_mismatch",    // tx_fees row missing or disagreeing with the replay
+}
+
+// verifyReport collects issues per category, keeping a few examples each.
+type verifyReport struct {
+    maxExamples int
+    counts      map[string]int
+    examples    map[string][]string
+}
+
+func (r *verifyReport) add(category, format string, args ...interface{}) {
+    r.counts[category]++
+    if len(r.examples[category]) < r.maxExamples {
+        r.examples[category] = append(r.examples[category], fmt.Sprintf(format, args...))
+    }
+}
+
+func (r *verifyReport) total() int {
+    n := 0
+    for _, c := range r.counts {
+        n += c
+    }
+    return n
+}
+
+func (r *verifyReport) print(events, expected, rows int) {
+    fmt.Printf("Replayed %d events into %d VTXOs; compared with %d stored rows\n\n", events, expected, rows)
+    for _, c := range verifyCategories {
+        fmt.Printf("%-16s %d\n", c, r.counts[c])
+    }
+    for _, c := range verifyCategories {
+        if len(r.examples[c]) == 0 {
+            continue
+        }
+        fmt.Printf("\n%s (first %d):\n", c, len(r.examples[c]))
+        for _, e := range r.examples[c] {
+            fmt.Printf("  %s\n", e)
+        }
+    }
+}
+
+// replayEvents applies the event archive, oldest first, to an in-memory
+// model of what the vtxos and tx_fees tables should contain, mirroring the
+// ingester's upserts.
+func replayEvents(ctx context.Context, asp string, report *verifyReport) (map[string]*VTXO, map[string]*TxFee, int, error) {
+    expected := make(map[string]*VTXO)
+    fees := make(map[string]*TxFee)
+    events := 0
+
+    q := whereASP(DB.NewSelect().Model((*Events)(nil)), asp).Order("timestamp_ms ASC", "id ASC")
+    err := iterateRows(ctx, q, func(e *Events) error {
+        events++
+        var data map[string]interface{}
+        if err := json.Unmarshal([]byte(e.Eventdata), &data); err != nil {
+            report.add("parse_failure", "event %d (%s): invalid JSON: %v", e.ID, e.Asp, err)
+            return nil
+        }
+        if _, ok := data["heartbeat"]; ok {
+            return nil
+        }
+        tx, err := decodeEvent(e.Asp, data, e.Timestamp_ms/1000)
+        if err != nil {
+            report.add("parse_failure", "event %d (%s): %v", e.ID, e.Asp, err)
+            return nil
+        }
+
+        if fee := txFeeFromTx(tx); fee.InputCount > 0 || fee.OutputCount > 0 {
+            fees[fee.Txid] = fee
+            if fee.isUnbalanced() {
+                report.add("unbalanced_tx", "%s (event %d): %d in, %d out", tx.Txid, e.ID, fee.InputAmount, fee.OutputAmount)
+            }
+        }
+
+        for i := range tx.Outputs {
+            out := tx.Outputs[i].VTXO
+            key := outpointKey(out.Txid, out.Vout)
+            if prev := expected[key]; prev != nil {
+                // Duplicate key: only tx_type and the sweep flag are updated
+                prev.TxType = out.TxType
+                prev.IsSwept = out.IsSwept
+                continue
+            }
+            expected[key] = &out
+        }
+        for i := range tx.Inputs {
+            in := tx.Inputs[i].VTXO
+            key := outpointKey(in.Txid, in.Vout)
+            prev := expected[key]
+            if prev == nil {
+                report.add("orphan_spend", "%s spent by %s (event %d) was never created", key, tx.Txid, e.ID)
+                expected[key] = &in
+                continue
+            }
+            prev.TxType = in.TxType
+            prev.IsSpent = true
+            prev.SpentBy = in.SpentBy
+            prev.SpentAt = in.SpentAt
+            prev.IsSwept = in.IsSwept
+        }
+        return nil
+    })
+    return expected, fees, events, err
+}
+
+// diffVTXOs compares every stored VTXO with the replayed model and reports
+// outpoints the table lacks or has in excess. It returns the row count.
+func diffVTXOs(ctx context.Context, asp string, expected map[string]*VTXO, report *verifyReport) (int, error) {
+    seen := make(map[string]bool, len(expected))
+    rows := 0
+
+    q := whereASP(DB.NewSelect().Model((*VTXO)(nil)), asp)
+    err := iterateRows(ctx, q, func(v *VTXO) error {
+        rows++
+        key := outpointKey(v.Txid, v.Vout)
+        want := expected[key]
+        if want == nil {
+            report.add("unexpected", "%s (%s, %s)", key, v.Asp, v.TxType)
+            return nil
+        }
+        seen[key] = true
+        if v.IsSpent != want.IsSpent {
+            report.add("spent_mismatch", "%s: stored is_spent=%t, events say %t", key, v.IsSpent, want.IsSpent)
+        }
+        if v.TxType != want.TxType {
+            report.add("tx_type_drift", "%s: stored %s, events say %s", key, v.TxType, want.TxType)
+        }
+        if v.Amount != want.Amount {
+            report.add("amount_mismatch", "%s: stored %d, events say %d", key, v.Amount, want.Amount)
+        }
+        return nil
+    })
+    if err != nil {
+        return rows, err
+    }
+
+    var missing []string
+    for key := range expected {
+        if !seen[key] {
+            missing = append(missing, key)
+        }
+    }
+    sort.Strings(missing)
+    for _, key := range missing {
+        report.add("missing", "%s (%s, %s)", key, expected[key].Asp, expected[key].TxType)
+    }
+    return rows, nil
+}
+
+// diffTxFees compares stored transaction balances with the replayed ones.
+func diffTxFees(ctx context.Context, asp string, expected map[string]*TxFee, report *verifyReport) error {
+    seen := make(map[string]bool, len(expected))
+    q := whereASP(DB.NewSelect().Model((*TxFee)(nil)), asp)
+    err := iterateRows(ctx, q, func(f *TxFee) error {
+        want := expected[f.Txid]
+        if want == nil {
+            report.add("fee_mismatch", "%s: stored but no event produced it", f.Txid)
+            return nil
+        }
+        seen[f.Txid] = true
+        if f.InputAmount != want.InputAmount || f.OutputAmount != want.OutputAmount {
+            report.add("fee_mismatch", "%s: stored %d in, %d out; events say %d in, %d out",
+                f.Txid, f.InputAmount, f.OutputAmount, want.InputAmount, want.OutputAmount)
+        }
+        return nil
+    })
+    if err != nil {
+        return err
+    }
+
+    var missing []string
+    for txid := range expected {
+        if !seen[txid] {
+            missing = append(missing, txid)
+        }
+    }
+    sort.Strings(missing)
+    for _, txid := range missing {
+        report.add("fee_mismatch", "%s: not stored", txid)
+    }
+    return nil
+}
+
+// runVerify implements `arkexplorer verify`, which replays the event
+// archive and diffs it against the vtxos table. It exits 1 when any
+// inconsistency is found, for use from cron.
+func runVerify(args []string) int {
+    fs := flag.NewFlagSet("verify", flag.ExitOnError)
+    asp := fs.String("asp", "", "Restrict to one ASP")
+    maxExamples := fs.Int("examples", 10, "Examples to print per category")
+    fs.Parse(args)
+
+    if err := InitDB(); err != nil {
+        log.Printf("Database error: %v", err)
+        return 2
+    }
+    ctx := context.Background()
+    DB.NewCreateTable().Model((*TxFee)(nil)).IfNotExists().Exec(ctx)
+
+    report := &verifyReport{
+        maxExamples: *maxExamples,
+        counts:      make(map[string]int),
+        examples:    make(map[string][]string),
+    }
+    expected, fees, events, err := replayEvents(ctx, *asp, report)
+    if err != nil {
+        log.Printf("Error reading events: %v", err)
+        return 2
+    }
+    rows, err := diffVTXOs(ctx, *asp, expected, report)
+    if err != nil {
+        log.Printf("Error reading VTXOs: %v", err)
+        return 2
+    }
+    if err := diffTxFees(ctx, *asp, fees, report); err != nil {
+        log.Printf("Error reading transaction fees: %v", err)
+        return 2
+    }
+
+    report.print(events, len(expected), rows)
+    if n := report.total(); n > 0 {
+        fmt.Printf("\nFAIL: %d issues\n", n)
+        return 1
+    }
+    fmt.Println("\nOK")
+    return 0
+}
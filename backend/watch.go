@@ -0,0 +1,455 @@
+package main
+
+import (
+    "bytes"
+    "context"
+    "crypto/hmac"
+    "crypto/rand"
+    "crypto/sha256"
+    "database/sql"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "log"
+    "net"
+    "net/http"
+    "net/url"
+    "strconv"
+    "strings"
+    "sync"
+    "syscall"
+    "time"
+)
+
+const (
+    webhookMaxAttempts = 8
+    webhookBaseBackoff = 30 * time.Second
+    webhookMaxBackoff  = time.Hour
+    webhookTimeout     = 5 * time.Second
+    // Hosts delivered to at once; each host still gets one request at a time
+    webhookConcurrency = 16
+)
+
+// webhookClient delivers webhooks. Callback URLs come from anyone, so it
+// refuses to connect to loopback, private and link-local addresses. The
+// check runs on the resolved address so DNS rebinding cannot get past it.
+var webhookClient = &http.Client{
+    Timeout: webhookTimeout,
+    Transport: &http.Transport{
+        DialContext: (&net.Dialer{
+            Timeout: webhookTimeout,
+            Control: func(network, address string, _ syscall.RawConn) error {
+                host, _, err := net.SplitHostPort(address)
+                if err != nil {
+                    return err
+                }
+                if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+                    return fmt.Errorf("refusing to connect to %s", host)
+                }
+                return nil
+            },
+        }).DialContext,
+        TLSHandshakeTimeout: webhookTimeout,
+    },
+}
+
+func isPublicIP(ip net.IP) bool {
+    return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+        ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+        ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// watchCache mirrors the watches table so processTransaction can match VTXOs
+// without a query per output. It stays empty (and matches nothing) until
+// loadWatches runs, which keeps backfills from queueing notifications.
+var watchCache struct {
+    sync.RWMutex
+    byScript   map[string][]Watch
+    byOutpoint map[string][]Watch
+}
+
+func outpointKey(txid string, vout int) string {
+    return txid + ":" + strconv.Itoa(vout)
+}
+
+func loadWatches(ctx context.Context) error {
+    var watches []Watch
+    if err := DB.NewSelect().Model(&watches).Scan(ctx); err != nil {
+        return err
+    }
+
+    byScript := make(map[string][]Watch)
+    byOutpoint := make(map[string][]Watch)
+    for _, w := range watches {
+        if w.Kind == "outpoint" {
+            key := outpointKey(w.Txid, w.Vout)
+            byOutpoint[key] = append(byOutpoint[key], w)
+        } else {
+            byScript[w.Script] = append(byScript[w.Script], w)
+        }
+    }
+
+    watchCache.Lock()
+    watchCache.byScript = byScript
+    watchCache.byOutpoint = byOutpoint
+    watchCache.Unlock()
+    return nil
+}
+
+func matchWatches(v *VTXO) []Watch {
+    watchCache.RLock()
+    defer watchCache.RUnlock()
+
+    var matches []Watch
+    matches = append(matches, watchCache.byScript[v.Script]...)
+    matches = append(matches, watchCache.byOutpoint[outpointKey(v.Txid, v.Vout)]...)
+    return matches
+}
+
+// notifyWatches queues a delivery for every watch matching v. event is one of
+// created, spent, swept or expiring.
+func notifyWatches(ctx context.Context, event, txid string, v *VTXO) {
+    for _, w := range matchWatches(v) {
+        queueDelivery(ctx, w, event, txid, v)
+    }
+}
+
+func queueDelivery(ctx context.Context, w Watch, event, txid string, v *VTXO) {
+    now := time.Now().Unix()
+    payload, err := json.Marshal(map[string]interface{}{
+        "watchId":   w.ID,
+        "event":     event,
+        "txid":      txid,
+        "asp":       v.Asp,
+        "vtxo":      v,
+        "timestamp": now * 1000,
+    })
+    if err != nil {
+        log.Printf("Error encoding webhook payload for watch %d: %v", w.ID, err)
+        return
+    }
+
+    _, err = DB.NewInsert().Model(&WebhookDelivery{
+        WatchID:       w.ID,
+        Event:         event,
+        Txid:          v.Txid,
+        Vout:          v.Vout,
+        Payload:       string(payload),
+        Status:        "pending",
+        NextAttemptAt: now,
+        CreatedAt:     now,
+    }).Exec(ctx)
+    if err != nil {
+        log.Printf("Error queueing webhook for watch %d: %v", w.ID, err)
+    }
+}
+
+// StartWebhookDispatcher delivers queued webhooks and scans watches for
+// VTXOs approaching expiry.
+func StartWebhookDispatcher(ctx context.Context) {
+    deliverTicker := time.NewTicker(5 * time.Second)
+    expiryTicker := time.NewTicker(5 * time.Minute)
+    defer deliverTicker.Stop()
+    defer expiryTicker.Stop()
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-deliverTicker.C:
+            deliverPendingWebhooks(ctx)
+        case <-expiryTicker.C:
+            checkExpiringWatches(ctx)
+        }
+    }
+}
+
+func deliverPendingWebhooks(ctx context.Context) {
+    var deliveries []WebhookDelivery
+    err := DB.NewSelect().
+        Model(&deliveries).
+        Where("status = ? AND next_attempt_at <= ?", "pending", time.Now().Unix()).
+        Order("id ASC").
+        Limit(100).
+        Scan(ctx)
+    if err != nil {
+        log.Printf("Error fetching pending webhooks: %v", err)
+        return
+    }
+
+    // Deliver to different hosts in parallel so slow callbacks only hold up
+    // their own host
+    byHost := make(map[string][]WebhookDelivery)
+    watches := make(map[int64]*Watch)
+    for _, d := range deliveries {
+        watch, ok := watches[d.WatchID]
+        if !ok {
+            watch = new(Watch)
+            if err := DB.NewSelect().Model(watch).Where("id = ?", d.WatchID).Scan(ctx); err != nil {
+                watch = nil
+            }
+            watches[d.WatchID] = watch
+        }
+        if watch == nil {
+            // Watch was deleted, nothing left to notify
+            d.Status = "cancelled"
+            DB.NewUpdate().Model(&d).Column("status").WherePK().Exec(ctx)
+            continue
+        }
+        host := watch.CallbackURL
+        if u, err := url.Parse(watch.CallbackURL); err == nil {
+            host = u.Host
+        }
+        byHost[host] = append(byHost[host], d)
+    }
+
+    var wg sync.WaitGroup
+    slots := make(chan struct{}, webhookConcurrency)
+    for _, queue := range byHost {
+        wg.Add(1)
+        slots <- struct{}{}
+        go func() {
+            defer wg.Done()
+            defer func() { <-slots }()
+            for i := range queue {
+                deliverWebhook(ctx, *watches[queue[i].WatchID], &queue[i])
+            }
+        }()
+    }
+    wg.Wait()
+}
+
+func deliverWebhook(ctx context.Context, watch Watch, d *WebhookDelivery) {
+    now := time.Now()
+    d.Attempts++
+
+    req, err := http.NewRequestWithContext(ctx, "POST", watch.CallbackURL, bytes.NewReader([]byte(d.Payload)))
+    if err == nil {
+        req.Header.Set("Content-Type", "application/json")
+        req.Header.Set("X-Ark-Delivery", strconv.FormatInt(d.ID, 10))
+        req.Header.Set("X-Ark-Event", d.Event)
+        req.Header.Set("X-Ark-Signature", "sha256="+signPayload(watch.Secret, d.Payload))
+
+        var resp *http.Response
+        resp, err = webhookClient.Do(req)
+        if err == nil {
+            resp.Body.Close()
+            d.ResponseCode = resp.StatusCode
+            if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+                err = fmt.Errorf("callback returned %d", resp.StatusCode)
+            }
+        }
+    }
+
+    if err == nil {
+        d.Status = "delivered"
+        d.DeliveredAt = now.Unix()
+        d.LastError = ""
+    } else {
+        d.LastError = err.Error()
+        if d.Attempts >= webhookMaxAttempts {
+            d.Status = "failed"
+        } else {
+            backoff := webhookBaseBackoff << (d.Attempts - 1)
+            if backoff > webhookMaxBackoff {
+                backoff = webhookMaxBackoff
+            }
+            d.NextAttemptAt = now.Add(backoff).Unix()
+        }
+    }
+
+    _, err = DB.NewUpdate().
+        Model(d).
+        Column("status", "attempts", "last_error", "response_code", "next_attempt_at", "delivered_at").
+        WherePK().
+        Exec(ctx)
+    if err != nil {
+        log.Printf("Error updating webhook delivery %d: %v", d.ID, err)
+    }
+}
+
+// signPayload returns the hex HMAC-SHA256 of body keyed by the watch secret,
+// sent as X-Ark-Signature so receivers can authenticate deliveries.
+func signPayload(secret, body string) string {
+    mac := hmac.New(sha256.New, []byte(secret))
+    mac.Write([]byte(body))
+    return hex.EncodeToString(mac.Sum(nil))
+}
+
+func checkExpiringWatches(ctx context.Context) {
+    var watches []Watch
+    if err := DB.NewSelect().Model(&watches).Where("expiry_hours > 0").Scan(ctx); err != nil {
+        log.Printf("Error fetching expiry watches: %v", err)
+        return
+    }
+
+    now := time.Now().Unix()
+    for _, w := range watches {
+        var vtxos []VTXO
+        q := DB.NewSelect().
+            Model(&vtxos).
+            Where("is_spent = ? AND expires_at > ? AND expires_at <= ?", false, now, now+int64(w.ExpiryHours)*3600).
+            Where("NOT EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.watch_id = ? AND d.event = 'expiring' AND d.txid = vtxo.txid AND d.vout = vtxo.vout)", w.ID)
+        if w.Kind == "outpoint" {
+            q = q.Where("txid = ? AND vout = ?", w.Txid, w.Vout)
+        } else {
+            q = q.Where("script = ?", w.Script)
+        }
+        if err := q.Scan(ctx); err != nil {
+            log.Printf("Error checking expiry for watch %d: %v", w.ID, err)
+            continue
+        }
+
+        for i := range vtxos {
+            queueDelivery(ctx, w, "expiring", vtxos[i].Txid, &vtxos[i])
+        }
+    }
+}
+
+// HandleWatches creates (POST) and removes (DELETE) watches. The secret
+// returned on creation signs deliveries and authorizes later calls.
+func HandleWatches(w http.ResponseWriter, r *http.Request) error {
+    switch r.Method {
+    case "POST":
+        return createWatch(w, r)
+    case "DELETE":
+        return deleteWatch(w, r)
+    default:
+        w.Header().Set("Allow", "POST, DELETE")
+        return methodNotAllowed()
+    }
+}
+
+func createWatch(w http.ResponseWriter, r *http.Request) error {
+    var req struct {
+        Address     string `json:"address"`
+        Script      string `json:"script"`
+        Outpoint    string `json:"outpoint"`
+        CallbackURL string `json:"callbackUrl"`
+        ExpiryHours int    `json:"expiryHours"`
+    }
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        return badRequest("invalid JSON body")
+    }
+
+    u, err := url.Parse(req.CallbackURL)
+    if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+        return badRequest("callbackUrl must be an http(s) URL")
+    }
+    // Names are checked again when delivering, once resolved
+    if ip := net.ParseIP(u.Hostname()); (ip != nil && !isPublicIP(ip)) || strings.EqualFold(u.Hostname(), "localhost") {
+        return badRequest("callbackUrl must be a public address")
+    }
+    if req.ExpiryHours < 0 {
+        return badRequest("expiryHours must not be negative")
+    }
+
+    watch := &Watch{
+        CallbackURL: req.CallbackURL,
+        ExpiryHours: req.ExpiryHours,
+        CreatedAt:   time.Now().Unix(),
+    }
+
+    switch {
+    case req.Address != "":
+        script, err := arkAddressScript(req.Address)
+        if err != nil {
+            return badRequest("invalid address: %v", err)
+        }
+        watch.Kind, watch.Target, watch.Script = "address", req.Address, script
+    case req.Script != "":
+        if _, err := hex.DecodeString(req.Script); err != nil {
+            return badRequest("script must be hex")
+        }
+        watch.Kind, watch.Target, watch.Script = "script", req.Script, strings.ToLower(req.Script)
+    case req.Outpoint != "":
+        txid, voutStr, ok := strings.Cut(req.Outpoint, ":")
+        vout, err := strconv.Atoi(voutStr)
+        if !ok || txid == "" || err != nil || vout < 0 {
+            return badRequest("outpoint must be txid:vout")
+        }
+        watch.Kind, watch.Target, watch.Txid, watch.Vout = "outpoint", req.Outpoint, txid, vout
+    default:
+        return badRequest("one of address, script or outpoint is required")
+    }
+
+    secret := make([]byte, 32)
+    if _, err := rand.Read(secret); err != nil {
+        return err
+    }
+    watch.Secret = hex.EncodeToString(secret)
+
+    ctx := r.Context()
+    if _, err := DB.NewInsert().Model(watch).Exec(ctx); err != nil {
+        return err
+    }
+    if err := loadWatches(ctx); err != nil {
+        logf(ctx, "Error reloading watches: %v", err)
+    }
+
+    return writeJSON(w, http.StatusCreated, watch)
+}
+
+func deleteWatch(w http.ResponseWriter, r *http.Request) error {
+    ctx := r.Context()
+    watch, err := authorizeWatch(r)
+    if err != nil {
+        return err
+    }
+
+    if _, err := DB.NewDelete().Model(watch).WherePK().Exec(ctx); err != nil {
+        return err
+    }
+    if err := loadWatches(ctx); err != nil {
+        logf(ctx, "Error reloading watches: %v", err)
+    }
+
+    w.WriteHeader(http.StatusNoContent)
+    return nil
+}
+
+// GetWatchDeliveries returns the delivery log of a watch, newest first.
+func GetWatchDeliveries(w http.ResponseWriter, r *http.Request) error {
+    watch, err := authorizeWatch(r)
+    if err != nil {
+        return err
+    }
+
+    deliveries := make([]WebhookDelivery, 0)
+    err = DB.NewSelect().
+        Model(&deliveries).
+        Where("watch_id = ?", watch.ID).
+        Order("id DESC").
+        Limit(100).
+        Scan(r.Context())
+    if err != nil {
+        return err
+    }
+
+    return writeJSON(w, http.StatusOK, deliveries)
+}
+
+// authorizeWatch loads the watch named by ?id= and checks the X-Watch-Secret
+// header against it.
+func authorizeWatch(r *http.Request) (*Watch, error) {
+    raw := r.PathValue("id")
+    if raw == "" {
+        raw = r.URL.Query().Get("id")
+    }
+    id, err := strconv.ParseInt(raw, 10, 64)
+    if err != nil {
+        return nil, badRequest("id required")
+    }
+
+    watch := new(Watch)
+    if err := DB.NewSelect().Model(watch).Where("id = ?", id).Scan(r.Context()); err != nil {
+        if errors.Is(err, sql.ErrNoRows) {
+            return nil, notFound("watch not found")
+        }
+        return nil, err
+    }
+    if !hmac.Equal([]byte(watch.Secret), []byte(r.Header.Get("X-Watch-Secret"))) {
+        return nil, forbidden("invalid watch secret")
+    }
+    return watch, nil
+}
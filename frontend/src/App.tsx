@@ -39,20 +39,31 @@ function App() {
   };
 
   useEffect(() => {
-    fetch(`/api/recent-transactions`)
+    fetch(`/api/v1/recent-transactions`)
       .then(res => res.json())
       .then((data) => setRecentTxs(data))
       .catch(err => console.error('Error fetching transactions:', err));
+
+    // New transactions are pushed over SSE instead of polling
+    const live = new EventSource('/api/v1/live');
+    live.addEventListener('transaction', (e) => {
+      const tx = JSON.parse((e as MessageEvent).data);
+      setRecentTxs(prev => [
+        { txid: tx.txid, createdAt: tx.createdAt, txType: tx.txType },
+        ...prev.filter(p => p.txid !== tx.txid),
+      ].slice(0, 10));
+    });
+    return () => live.close();
   }, []);
 
   useEffect(() => {
     const fetchData = async () => {
       try {
-        const statsRes = await fetch(`/api/stats?timeframe=${timeframe}`);
+        const statsRes = await fetch(`/api/v1/stats?timeframe=${timeframe}`);
         const statsData = await statsRes.json();
         setStats(statsData);
 
-        const trendsRes = await fetch(`/api/trends?timeframe=${timeframe}`);
+        const trendsRes = await fetch(`/api/v1/trends?timeframe=${timeframe}`);
         const trendsData = await trendsRes.json();
 
         if (Array.isArray(trendsData)) {
@@ -85,7 +96,7 @@ function App() {
     setHasSearched(true);
 
     try {
-      const response = await fetch(`/api/search?txid=${encodeURIComponent(searchTerm.trim())}`);
+      const response = await fetch(`/api/v1/tx/${encodeURIComponent(searchTerm.trim())}`);
 
       if (!response.ok) throw new Error('Transaction not found');
 
@@ -1,8 +1,9 @@
 import React from "react";
 import { ArrowUpRight, ArrowDownRight } from "lucide-react";
 
-const TrendIndicator = ({ value }: { value?: number }) => {
-  if (value === undefined || value === 0) return null;
+const TrendIndicator = ({ value }: { value?: number | null }) => {
+  // null when the previous period had nothing to compare with
+  if (value === undefined || value === null || value === 0) return null;
   const isPositive = value > 0;
   
   return (
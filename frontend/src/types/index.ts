@@ -6,8 +6,8 @@ export interface NetworkStats {
   virtualTxVolume: number;
   timeframe: string;
   // Add these trend fields:
-  txCountChange?: number; // e.g., 12.5 or -5.0
-  volumeChange?: number;   // e.g., 2.3 or -10.1
+  txCountChange?: number | null; // e.g., 12.5 or -5.0; null when undefined
+  volumeChange?: number | null;   // e.g., 2.3 or -10.1
 }
 
 export interface TrendPoint {
@@ -28,4 +28,5 @@ export interface VTXO {
   expiresAt: number;
   spentBy: string;
   script: string;
+  asp: string;
 }
\ No newline at end of file